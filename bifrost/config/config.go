@@ -14,12 +14,13 @@ import (
 )
 
 type Configuration struct {
-	Signer    SignerConfiguration  `json:"signer" mapstructure:"signer"`
-	Thorchain ClientConfiguration  `json:"thorchain" mapstructure:"thorchain"`
-	Metrics   MetricsConfiguration `json:"metrics" mapstructure:"metrics"`
-	Chains    []ChainConfiguration `json:"chains" mapstructure:"chains"`
-	TSS       TSSConfiguration     `json:"tss" mapstructure:"tss"`
-	BackOff   BackOff              `json:"back_off" mapstructure:"back_off"`
+	Signer    SignerConfiguration   `json:"signer" mapstructure:"signer"`
+	Thorchain ClientConfiguration   `json:"thorchain" mapstructure:"thorchain"`
+	Metrics   MetricsConfiguration  `json:"metrics" mapstructure:"metrics"`
+	Chains    []ChainConfiguration  `json:"chains" mapstructure:"chains"`
+	TSS       TSSConfiguration      `json:"tss" mapstructure:"tss"`
+	BackOff   BackOff               `json:"back_off" mapstructure:"back_off"`
+	Solvency  SolvencyConfiguration `json:"solvency" mapstructure:"solvency"`
 }
 
 // SignerConfiguration all the configures need by signer
@@ -29,6 +30,16 @@ type SignerConfiguration struct {
 	RetryInterval time.Duration             `json:"retry_interval" mapstructure:"retry_interval"`
 }
 
+// SolvencyConfiguration settings for the vault solvency checker
+type SolvencyConfiguration struct {
+	Enabled       bool          `json:"enabled" mapstructure:"enabled"`
+	CheckInterval time.Duration `json:"check_interval" mapstructure:"check_interval"`
+	// Tolerance is how much less than expected a vault can hold, in basis points
+	Tolerance int64 `json:"tolerance" mapstructure:"tolerance"`
+	// FailureThreshold is how many checks in a row have to fail before vaults are reported insolvent
+	FailureThreshold int `json:"failure_threshold" mapstructure:"failure_threshold"`
+}
+
 // BackOff configuration
 type BackOff struct {
 	InitialInterval     time.Duration `json:"initial_interval" mapstructure:"initial_interval"`
@@ -152,6 +163,10 @@ func applyDefaultConfig() {
 	viper.SetDefault("back_off.multiplier", 1.5)
 	viper.SetDefault("back_off.max_interval", 3*time.Minute)
 	viper.SetDefault("back_off.max_elapsed_time", 168*time.Hour) // 7 days. Due to node sync time's being so random
+	viper.SetDefault("solvency.enabled", true)
+	viper.SetDefault("solvency.check_interval", 5*time.Minute)
+	viper.SetDefault("solvency.tolerance", 10)
+	viper.SetDefault("solvency.failure_threshold", 3)
 	applyDefaultSignerConfig()
 }
 
@@ -37,6 +37,18 @@ const (
 	SignerError   MetricName = `signer_error`
 
 	PubKeyManagerError MetricName = `pubkey_manager_error`
+
+	SolvencyCheckerError    MetricName = `solvency_checker_error`
+	VaultBalanceDiscrepancy MetricName = `vault_balance_discrepancy`
+
+	TSSKeygenDuration   MetricName = `tss_keygen_duration`
+	TSSKeysignDuration  MetricName = `tss_keysign_duration`
+	TSSKeygenPartySize  MetricName = `tss_keygen_party_size`
+	TSSKeysignPartySize MetricName = `tss_keysign_party_size`
+	TSSKeygen           MetricName = `tss_keygen`
+	TSSKeysign          MetricName = `tss_keysign`
+	TSSBlame            MetricName = `tss_blame`
+	SignerKeysignRetry  MetricName = `signer_keysign_retry`
 )
 
 // Metrics used to provide promethus metrics
@@ -132,6 +144,57 @@ var (
 		}, []string{
 			"error_name", "additional",
 		}),
+		SolvencyCheckerError: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "solvency",
+			Subsystem: "solvency_checker",
+			Name:      "errors",
+			Help:      "errors in solvency checker",
+		}, []string{
+			"error_name", "additional",
+		}),
+		TSSKeygen: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "tss",
+			Subsystem: "keygen",
+			Name:      "total",
+			Help:      "number of keygen ceremonies by result",
+		}, []string{
+			"result",
+		}),
+		TSSKeysign: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "tss",
+			Subsystem: "keysign",
+			Name:      "total",
+			Help:      "number of keysign ceremonies by result",
+		}, []string{
+			"result",
+		}),
+		TSSBlame: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "tss",
+			Subsystem: "blame",
+			Name:      "total",
+			Help:      "number of times a node got blamed for a failed keygen or keysign",
+		}, []string{
+			"ceremony", "reason", "pubkey",
+		}),
+		SignerKeysignRetry: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "signer",
+			Subsystem: "keysign",
+			Name:      "retry",
+			Help:      "number of outbound txs the signer has to sign again after a failed keysign",
+		}, []string{
+			"chain",
+		}),
+	}
+
+	gaugeVecs = map[MetricName]*prometheus.GaugeVec{
+		VaultBalanceDiscrepancy: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "solvency",
+			Subsystem: "solvency_checker",
+			Name:      "vault_balance_discrepancy",
+			Help:      "vault balance on THORChain minus the balance on chain, positive means the vault is short of funds",
+		}, []string{
+			"chain", "vault", "asset",
+		}),
 	}
 
 	histograms = map[MetricName]prometheus.Histogram{
@@ -153,6 +216,34 @@ var (
 			Name:      "send_to_thorchain_duration",
 			Help:      "how long it takes to sign and broadcast to binance",
 		}),
+		TSSKeygenDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "tss",
+			Subsystem: "keygen",
+			Name:      "duration",
+			Help:      "how long it takes to complete a keygen ceremony",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		}),
+		TSSKeysignDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "tss",
+			Subsystem: "keysign",
+			Name:      "duration",
+			Help:      "how long it takes to complete a keysign ceremony",
+			Buckets:   prometheus.ExponentialBuckets(0.5, 2, 10),
+		}),
+		TSSKeygenPartySize: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "tss",
+			Subsystem: "keygen",
+			Name:      "party_size",
+			Help:      "number of nodes taking part in a keygen ceremony",
+			Buckets:   prometheus.LinearBuckets(5, 5, 20),
+		}),
+		TSSKeysignPartySize: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "tss",
+			Subsystem: "keysign",
+			Name:      "party_size",
+			Help:      "number of nodes taking part in a keysign ceremony",
+			Buckets:   prometheus.LinearBuckets(5, 5, 20),
+		}),
 	}
 )
 
@@ -172,6 +263,9 @@ func NewMetrics(cfg config.MetricsConfiguration) (*Metrics, error) {
 	for _, item := range histograms {
 		prometheus.MustRegister(item)
 	}
+	for _, item := range gaugeVecs {
+		prometheus.MustRegister(item)
+	}
 	// create a new mux server
 	server := http.NewServeMux()
 	// register a new handler for the /metrics endpoint
@@ -214,6 +308,14 @@ func (m *Metrics) GetCounterVec(name MetricName) *prometheus.CounterVec {
 	return nil
 }
 
+// GetGaugeVec return a gauge vec by name, if it doesn't exist, then it return nil
+func (m *Metrics) GetGaugeVec(name MetricName) *prometheus.GaugeVec {
+	if g, ok := gaugeVecs[name]; ok {
+		return g
+	}
+	return nil
+}
+
 // Start
 func (m *Metrics) Start() error {
 	if !m.cfg.Enabled {
@@ -148,6 +148,10 @@ func (o *Observer) filterObservations(chain common.Chain, items []types.TxInItem
 			txs = append(txs, txInItem)
 		}
 		// check if the to address is a valid pool address
+		// a vault sending to itself has been added above already
+		if txInItem.To == txInItem.Sender {
+			continue
+		}
 		if ok, cpi := o.pubkeyMgr.IsValidPoolAddress(txInItem.To, chain); ok {
 			txInItem.ObservedVaultPubKey = cpi.PubKey
 			txs = append(txs, txInItem)
@@ -51,7 +51,7 @@ type Binance struct {
 
 // NewBinance create new instance of binance client
 func NewBinance(thorKeys *thorclient.Keys, cfg config.ChainConfiguration, server *tssp.TssServer, thorchainBridge *thorclient.ThorchainBridge, m *metrics.Metrics) (*Binance, error) {
-	tssKm, err := tss.NewKeySign(server)
+	tssKm, err := tss.NewKeySign(server, m)
 	if err != nil {
 		return nil, fmt.Errorf("fail to create tss signer: %w", err)
 	}
@@ -404,7 +404,7 @@ func (b *Binance) signMsg(signMsg btx.StdSignMsg, from string, poolPubKey common
 			return nil, err
 		} else {
 			b.logger.Info().Str("tx_id", txID.String()).Msgf("post keysign failure to thorchain")
-			return nil, fmt.Errorf("sent keysign failure to thorchain")
+			return nil, fmt.Errorf("sent keysign failure to thorchain: %w", keysignError)
 		}
 	}
 	b.logger.Error().Err(err).Msgf("fail to sign msg with memo: %s", signMsg.Memo)
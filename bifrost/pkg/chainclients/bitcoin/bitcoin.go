@@ -30,6 +30,9 @@ import (
 // BlockCacheSize the number of block meta that get store in storage.
 const BlockCacheSize = 100
 
+// MaxMemoOutputs the maximum number of OP_RETURN outputs an inbound tx can split its memo across, each of them carries up to 80 bytes
+const MaxMemoOutputs = 4
+
 // Client observes bitcoin chain and allows to sign and broadcast tx
 type Client struct {
 	logger            zerolog.Logger
@@ -43,6 +46,10 @@ type Client struct {
 	bridge            *thorclient.ThorchainBridge
 	globalErrataQueue chan<- types.ErrataBlock
 	nodePubKey        common.PubKey
+	consolidating     int32
+	replacing         int32
+	lastFeeRate       int64
+	lastFeeRateHeight int64
 }
 
 // NewClient generates a new Client
@@ -57,7 +64,7 @@ func NewClient(thorKeys *thorclient.Keys, cfg config.ChainConfiguration, server
 	if err != nil {
 		return nil, fmt.Errorf("fail to create bitcoin rpc client: %w", err)
 	}
-	tssKm, err := tss.NewKeySign(server)
+	tssKm, err := tss.NewKeySign(server, m)
 	if err != nil {
 		return nil, fmt.Errorf("fail to create tss signer: %w", err)
 	}
@@ -312,6 +319,12 @@ func (c *Client) FetchTxs(height int64) (types.TxIn, error) {
 		blockMeta.PreviousHash = block.PreviousHash
 		blockMeta.BlockHash = block.Hash
 	}
+	feeRate, err := c.getBlockFeeRate(block.Height)
+	if err != nil {
+		c.logger.Err(err).Int64("height", block.Height).Msg("fail to get block fee rate")
+	} else {
+		blockMeta.FeeRate = feeRate
+	}
 
 	if err := c.blockMetaAccessor.SaveBlockMeta(block.Height, blockMeta); err != nil {
 		return types.TxIn{}, fmt.Errorf("fail to save block meta into storage: %w", err)
@@ -328,6 +341,11 @@ func (c *Client) FetchTxs(height int64) (types.TxIn, error) {
 	if err != nil {
 		return types.TxIn{}, fmt.Errorf("fail to extract txs from block: %w", err)
 	}
+	if stuck := c.processSignedTxs(block); len(stuck) > 0 {
+		go c.replaceStuckTxs(stuck, block.Height)
+	}
+	go c.reportNetworkFee(block.Height)
+	go c.consolidateUTXOs(block.Height)
 	return txs, nil
 }
 
@@ -391,15 +409,16 @@ func (c *Client) extractTxs(block *btcjson.GetBlockVerboseTxResult) (types.TxIn,
 // vout:1 is any any change back to themselves
 // vout:2 is OP_RETURN (first 80 bytes)
 // vout:3 is OP_RETURN (next 80 bytes)
+// ... up to MaxMemoOutputs OP_RETURN outputs
 //
 // Rules to ignore a tx are:
 // - vout:0 doesn't have coins (value)
 // - vout:0 doesn't have address
-// - count vouts > 4
+// - count vouts > 2 + MaxMemoOutputs
 // - count vouts with coins (value) > 2
 //
 func (c *Client) ignoreTx(tx *btcjson.TxRawResult) bool {
-	if len(tx.Vin) == 0 || len(tx.Vout) == 0 || len(tx.Vout) > 4 {
+	if len(tx.Vin) == 0 || len(tx.Vout) == 0 || len(tx.Vout) > 2+MaxMemoOutputs {
 		return true
 	}
 	if tx.Vout[0].Value == 0 || tx.Vin[0].Txid == "" {
@@ -427,12 +446,19 @@ func (c *Client) ignoreTx(tx *btcjson.TxRawResult) bool {
 // back to the vault and we need to select the other output
 // as Bifrost already filtered the txs to only have here
 // txs with max 2 outputs with values
+// when all the outputs pay back to the sender (a vault consolidating
+// its utxos), the first output with value is selected
 func (c *Client) getOutput(sender string, tx *btcjson.TxRawResult) btcjson.Vout {
 	for _, vout := range tx.Vout {
 		if vout.Value > 0 && vout.ScriptPubKey.Addresses[0] != sender {
 			return vout
 		}
 	}
+	for _, vout := range tx.Vout {
+		if vout.Value > 0 {
+			return vout
+		}
+	}
 	return btcjson.Vout{}
 }
 
@@ -457,13 +483,20 @@ func (c *Client) getSender(tx *btcjson.TxRawResult) (string, error) {
 }
 
 // getMemo returns memo for a btc tx, using vout OP_RETURN
+// a memo longer than 80 bytes can be split across multiple OP_RETURN outputs, they are joined in the order of the outputs
+// a short memo reference (REF:<id>) is kept as is, THORChain resolves it to the memo registered on chain
 func (c *Client) getMemo(tx *btcjson.TxRawResult) (string, error) {
 	var opreturns string
 	for _, vout := range tx.Vout {
-		if strings.HasPrefix(vout.ScriptPubKey.Asm, "OP_RETURN") {
-			opreturn := strings.Split(vout.ScriptPubKey.Asm, " ")
-			opreturns += opreturn[1]
+		if !strings.HasPrefix(vout.ScriptPubKey.Asm, "OP_RETURN") {
+			continue
+		}
+		opreturn := strings.Fields(vout.ScriptPubKey.Asm)
+		// OP_RETURN without any data
+		if len(opreturn) < 2 {
+			continue
 		}
+		opreturns += opreturn[1]
 	}
 	decoded, err := hex.DecodeString(opreturns)
 	if err != nil {
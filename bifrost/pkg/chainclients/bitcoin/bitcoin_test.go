@@ -118,6 +118,10 @@ func (s *BitcoinSuite) SetUpTest(c *C) {
 			}
 		case r.Method == "getblockcount":
 			httpTestHandler(c, rw, "../../../../test/fixtures/btc/blockcount.json")
+		case r.Method == "getblockstats":
+			httpTestHandler(c, rw, "../../../../test/fixtures/btc/getblockstats.json")
+		case r.Method == "estimatesmartfee":
+			httpTestHandler(c, rw, "../../../../test/fixtures/btc/estimatesmartfee.json")
 		}
 	}))
 
@@ -162,6 +166,37 @@ func (s *BitcoinSuite) TestFetchTxs(c *C) {
 	c.Assert(txs.TxArray[0].Coins.Equals(common.Coins{common.NewCoin(common.BTCAsset, sdk.NewUint(10000000))}), Equals, true)
 	c.Assert(txs.TxArray[0].Gas.Equals(common.Gas{common.NewCoin(common.BTCAsset, sdk.NewUint(22705334))}), Equals, true)
 	c.Assert(len(txs.TxArray), Equals, 105)
+	blockMeta, err := s.client.blockMetaAccessor.GetBlockMeta(1696761)
+	c.Assert(err, IsNil)
+	c.Assert(blockMeta, NotNil)
+	c.Assert(blockMeta.FeeRate, Equals, int64(12))
+}
+
+func (s *BitcoinSuite) TestEstimateFeeRate(c *C) {
+	// only the fee rate estimated by the bitcoin node, 0.00018 BTC per kvbyte
+	feeRate, err := s.client.estimateFeeRate()
+	c.Assert(err, IsNil)
+	c.Assert(feeRate, Equals, int64(18))
+
+	// the median fee rate of the last FeeRateBlocks blocks is higher
+	for i, rate := range []int64{1, 5, 40, 30, 8, 100, 50} {
+		blockMeta := NewBlockMeta("", int64(100+i), "")
+		blockMeta.FeeRate = rate
+		c.Assert(s.client.blockMetaAccessor.SaveBlockMeta(blockMeta.Height, blockMeta), IsNil)
+	}
+	feeRate, err = s.client.estimateFeeRate()
+	c.Assert(err, IsNil)
+	c.Assert(feeRate, Equals, int64(40))
+
+	// the fee rate of blocks is lower
+	for i := int64(0); i < FeeRateBlocks; i++ {
+		blockMeta := NewBlockMeta("", 200+i, "")
+		blockMeta.FeeRate = 3
+		c.Assert(s.client.blockMetaAccessor.SaveBlockMeta(blockMeta.Height, blockMeta), IsNil)
+	}
+	feeRate, err = s.client.estimateFeeRate()
+	c.Assert(err, IsNil)
+	c.Assert(feeRate, Equals, int64(18))
 }
 
 func (s *BitcoinSuite) TestGetSender(c *C) {
@@ -215,6 +250,35 @@ func (s *BitcoinSuite) TestGetMemo(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(memo, Equals, "swap:eth.0xc54c1512696F3EA7956bd9aD410818eEcADCFfff:0xc54c1512696F3EA7956bd9aD410818eEcADCFfff:10000000000")
 
+	// the memo is split across four OP_RETURN outputs, one of them doesn't have any data
+	tx = btcjson.TxRawResult{
+		Vout: []btcjson.Vout{
+			btcjson.Vout{
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Asm: "OP_RETURN 737761703a",
+				},
+			},
+			btcjson.Vout{
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Asm: "OP_RETURN",
+				},
+			},
+			btcjson.Vout{
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Asm: "OP_RETURN 6274632e6274633a",
+				},
+			},
+			btcjson.Vout{
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Asm: "OP_RETURN 313233",
+				},
+			},
+		},
+	}
+	memo, err = s.client.getMemo(&tx)
+	c.Assert(err, IsNil)
+	c.Assert(memo, Equals, "swap:btc.btc:123")
+
 	tx = btcjson.TxRawResult{
 		Vout: []btcjson.Vout{},
 	}
@@ -223,6 +287,36 @@ func (s *BitcoinSuite) TestGetMemo(c *C) {
 	c.Assert(memo, Equals, "")
 }
 
+func (s *BitcoinSuite) TestGetOutput(c *C) {
+	vault := "tb1qkq7weysjn6ljc2ywmjmwp8ttcckg8yyxjdz5k6"
+	customer := "tb1qj08ys4ct2hzzc2hcz6h2hgrvlmsjynawhcf2xa"
+	tx := btcjson.TxRawResult{
+		Vout: []btcjson.Vout{
+			btcjson.Vout{
+				Value: 0.5,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Addresses: []string{vault},
+				},
+			},
+			btcjson.Vout{
+				Value: 0.1,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Addresses: []string{customer},
+				},
+			},
+		},
+	}
+	// outbound, the change back to the vault is skipped
+	output := s.client.getOutput(vault, &tx)
+	c.Assert(output.ScriptPubKey.Addresses[0], Equals, customer)
+
+	// vault sending to itself
+	tx.Vout = tx.Vout[:1]
+	output = s.client.getOutput(vault, &tx)
+	c.Assert(output.ScriptPubKey.Addresses[0], Equals, vault)
+	c.Assert(output.Value, Equals, 0.5)
+}
+
 func (s *BitcoinSuite) TestIgnoreTx(c *C) {
 	// valid tx that will NOT be ignored
 	tx := btcjson.TxRawResult{
@@ -437,6 +531,52 @@ func (s *BitcoinSuite) TestIgnoreTx(c *C) {
 	}
 	ignored = s.client.ignoreTx(&tx)
 	c.Assert(ignored, Equals, false)
+
+	// valid tx with the memo split across MaxMemoOutputs OP_RETURN outputs
+	tx = btcjson.TxRawResult{
+		Vin: []btcjson.Vin{
+			btcjson.Vin{
+				Txid: "24ed2d26fd5d4e0e8fa86633e40faf1bdfc8d1903b1cd02855286312d48818a2",
+				Vout: 0,
+			},
+		},
+		Vout: []btcjson.Vout{
+			btcjson.Vout{
+				Value: 0.1234565,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Addresses: []string{
+						"bc1q0s4mg25tu6termrk8egltfyme4q7sg3h0e56p3",
+					},
+				},
+			},
+			btcjson.Vout{
+				Value: 0.1234565,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Addresses: []string{
+						"tb1qkq7weysjn6ljc2ywmjmwp8ttcckg8yyxjdz5k6",
+					},
+				},
+			},
+		},
+	}
+	for i := 0; i < MaxMemoOutputs; i++ {
+		tx.Vout = append(tx.Vout, btcjson.Vout{
+			ScriptPubKey: btcjson.ScriptPubKeyResult{
+				Asm: "OP_RETURN 74686f72636861696e3a636f6e736f6c6964617465",
+			},
+		})
+	}
+	ignored = s.client.ignoreTx(&tx)
+	c.Assert(ignored, Equals, false)
+
+	// invalid tx with too many OP_RETURN outputs
+	tx.Vout = append(tx.Vout, btcjson.Vout{
+		ScriptPubKey: btcjson.ScriptPubKeyResult{
+			Asm: "OP_RETURN 74686f72636861696e3a636f6e736f6c6964617465",
+		},
+	})
+	ignored = s.client.ignoreTx(&tx)
+	c.Assert(ignored, Equals, true)
 }
 
 func (s *BitcoinSuite) TestGetGas(c *C) {
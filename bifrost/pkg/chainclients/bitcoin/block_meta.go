@@ -12,6 +12,7 @@ type BlockMeta struct {
 	Height                    int64                      `json:"height"`
 	BlockHash                 string                     `json:"block_hash"`
 	UnspentTransactionOutputs []UnspentTransactionOutput `json:"utxos"`
+	FeeRate                   int64                      `json:"fee_rate"` // median fee rate (sats per vbyte) paid by the txs in the block
 }
 
 // NewBlockMeta create a new instance of BlockMeta
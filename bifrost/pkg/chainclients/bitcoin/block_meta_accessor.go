@@ -6,6 +6,7 @@ type BlockMetaAccessor interface {
 	GetBlockMeta(height int64) (*BlockMeta, error)
 	SaveBlockMeta(height int64, blockMeta *BlockMeta) error
 	PruneBlockMeta(height int64) error
-	UpsertTransactionFee(fee float64, vSize int32) error
-	GetTransactionFee() (float64, int32, error)
+	UpsertSignedTx(signedTx SignedTx) error
+	GetSignedTxs() ([]SignedTx, error)
+	RemoveSignedTx(txID string) error
 }
@@ -61,14 +61,24 @@ func (s *BitcoinBlockMetaAccessorTestSuite) TestBlockMetaAccessor(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(allBlockMetas, HasLen, 25)
 
-	fee, vSize, err := blockMetaAccessor.GetTransactionFee()
-	c.Assert(err, NotNil)
-	c.Assert(fee, Equals, 0.0)
-	c.Assert(vSize, Equals, int32(0))
-	// upsert transaction fee
-	c.Assert(blockMetaAccessor.UpsertTransactionFee(1.0, 1), IsNil)
-	fee, vSize, err = blockMetaAccessor.GetTransactionFee()
+	// signed txs
+	signedTxs, err := blockMetaAccessor.GetSignedTxs()
 	c.Assert(err, IsNil)
-	c.Assert(fee, Equals, 1.0)
-	c.Assert(vSize, Equals, int32(1))
+	c.Assert(signedTxs, HasLen, 0)
+	signedTx := SignedTx{
+		TxID:            thorchain.GetRandomTxHash().String(),
+		Payload:         []byte("payload"),
+		InputAmounts:    []int64{1000},
+		BroadcastHeight: 1024,
+	}
+	c.Assert(blockMetaAccessor.UpsertSignedTx(signedTx), IsNil)
+	signedTxs, err = blockMetaAccessor.GetSignedTxs()
+	c.Assert(err, IsNil)
+	c.Assert(signedTxs, HasLen, 1)
+	c.Assert(signedTxs[0].TxID, Equals, signedTx.TxID)
+	c.Assert(signedTxs[0].InputAmounts, DeepEquals, signedTx.InputAmounts)
+	c.Assert(blockMetaAccessor.RemoveSignedTx(signedTx.TxID), IsNil)
+	signedTxs, err = blockMetaAccessor.GetSignedTxs()
+	c.Assert(err, IsNil)
+	c.Assert(signedTxs, HasLen, 0)
 }
@@ -0,0 +1,223 @@
+package bitcoin
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/txsort"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gitlab.com/thorchain/txscript"
+
+	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
+	"gitlab.com/thorchain/thornode/common"
+	ttypes "gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+const (
+	// ConsolidateInterval how often (in BTC blocks) the chain client check whether the vaults need to consolidate their UTXOs
+	ConsolidateInterval = 6
+	// ConsolidateUTXOThreshold a vault holding more spendable UTXOs than this will consolidate them
+	ConsolidateUTXOThreshold = 20
+	// MaxUTXOsToConsolidate the maximum number of UTXOs merged by a single consolidate tx
+	MaxUTXOsToConsolidate = 100
+	// ConsolidateMaxFeeRate UTXOs are only consolidated when the fee rate (sats per vbyte) is not higher than this
+	ConsolidateMaxFeeRate = 10
+	// ConsolidateMemo the memo of consolidate tx, THORChain treats it as an internal tx that doesn't affect any pool
+	ConsolidateMemo = "consolidate"
+	// witnessVSize the estimated size (in vbytes) of the witness of a P2WPKH input
+	witnessVSize = 27
+)
+
+// consolidateUTXOs is called every time the chain client scanned a block, every ConsolidateInterval blocks it goes through the asgard vaults
+// this node is a member of, and merge the UTXOs of the vaults holding more than ConsolidateUTXOThreshold of them
+// into one by sending them back to the vault itself.
+// Every member of the keysign party has to build the same tx, so it uses the fee rate agreed on THORChain rather than
+// the local estimate, and only runs for the tip of the chain, not while catching up
+func (c *Client) consolidateUTXOs(height int64) {
+	if height%ConsolidateInterval != 0 {
+		return
+	}
+	// the previous consolidation is still in progress
+	if !atomic.CompareAndSwapInt32(&c.consolidating, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&c.consolidating, 0)
+
+	tip, err := c.client.GetBlockCount()
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get block height, skip utxo consolidation")
+		return
+	}
+	if height < tip {
+		return
+	}
+	networkFee, err := c.bridge.GetNetworkFee(common.BTCChain)
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get network fee, skip utxo consolidation")
+		return
+	}
+	feeRate := int64(networkFee.TransactionFeeRate)
+	if feeRate == 0 {
+		c.logger.Info().Msg("no network fee on THORChain yet, skip utxo consolidation")
+		return
+	}
+	if feeRate > ConsolidateMaxFeeRate {
+		c.logger.Info().Int64("fee_rate", feeRate).Msg("fee rate is too high, skip utxo consolidation")
+		return
+	}
+	vaults, err := c.bridge.GetAsgards()
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get asgard vaults from thorchain")
+		return
+	}
+	for _, vault := range vaults {
+		if vault.Status != ttypes.ActiveVault || !vault.Contains(c.nodePubKey) {
+			continue
+		}
+		if err := c.consolidateVault(vault.PubKey, height, feeRate); err != nil {
+			c.logger.Err(err).Str("vault", vault.PubKey.String()).Msg("fail to consolidate utxos")
+		}
+	}
+}
+
+// getUTXOsToConsolidate returns the UTXOs of the given vault that have enough confirmations, the oldest first
+func (c *Client) getUTXOsToConsolidate(height int64, pubKey common.PubKey) ([]UnspentTransactionOutput, error) {
+	blockMetas, err := c.blockMetaAccessor.GetBlockMetas()
+	if err != nil {
+		return nil, fmt.Errorf("fail to get block metas: %w", err)
+	}
+	utxos := make([]UnspentTransactionOutput, 0)
+	for _, b := range blockMetas {
+		if b.Height > height-MinUTXOConfirmation {
+			continue
+		}
+		utxos = append(utxos, b.GetUTXOs(pubKey)...)
+	}
+	// every member of the vault need to pick the same utxos
+	sort.SliceStable(utxos, func(i, j int) bool {
+		if utxos[i].BlockHeight != utxos[j].BlockHeight {
+			return utxos[i].BlockHeight < utxos[j].BlockHeight
+		}
+		return utxos[i].GetKey() < utxos[j].GetKey()
+	})
+	return utxos, nil
+}
+
+// consolidateVault sign and broadcast a tx that sends the oldest UTXOs of the given vault back to itself
+func (c *Client) consolidateVault(vaultPubKey common.PubKey, height, feeRate int64) error {
+	utxos, err := c.getUTXOsToConsolidate(height, vaultPubKey)
+	if err != nil {
+		return fmt.Errorf("fail to get utxos to consolidate: %w", err)
+	}
+	if len(utxos) <= ConsolidateUTXOThreshold {
+		return nil
+	}
+	keysignParty, err := c.bridge.GetKeysignParty(vaultPubKey)
+	if err != nil {
+		return fmt.Errorf("fail to get keysign party: %w", err)
+	}
+	if !keysignParty.Contains(c.nodePubKey) {
+		return nil
+	}
+	if len(utxos) > MaxUTXOsToConsolidate {
+		utxos = utxos[:MaxUTXOsToConsolidate]
+	}
+	vaultAddr, err := vaultPubKey.GetAddress(common.BTCChain)
+	if err != nil {
+		return fmt.Errorf("fail to get vault address: %w", err)
+	}
+	txOut := stypes.TxOutItem{
+		Chain:       common.BTCChain,
+		ToAddress:   vaultAddr,
+		VaultPubKey: vaultPubKey,
+		Memo:        ConsolidateMemo,
+	}
+	sourceScript, err := c.getSourceScript(txOut)
+	if err != nil {
+		return fmt.Errorf("fail to get source pay to address script: %w", err)
+	}
+	redeemTx, inputAmounts, err := buildConsolidateTx(utxos, sourceScript, feeRate)
+	if err != nil {
+		return fmt.Errorf("fail to build consolidate tx: %w", err)
+	}
+	txOut.Coins = common.Coins{
+		common.NewCoin(common.BTCAsset, sdk.NewUint(uint64(redeemTx.TxOut[0].Value))),
+	}
+
+	// mark the utxos as spent, so the signer will not spend them in an outbound at the same time
+	for _, utxo := range utxos {
+		if err := c.spendUTXO(utxo.GetKey()); err != nil {
+			c.logger.Err(err).Str("utxo", utxo.GetKey()).Msg("fail to mark utxo as spent")
+		}
+	}
+	if err := c.signAndBroadcastConsolidateTx(txOut, redeemTx, sourceScript, inputAmounts); err != nil {
+		for _, utxo := range utxos {
+			if err := c.unspendUTXO(utxo.GetKey()); err != nil {
+				c.logger.Err(err).Str("utxo", utxo.GetKey()).Msg("fail to mark utxo as unspent")
+			}
+		}
+		return err
+	}
+	c.logger.Info().Str("vault", vaultPubKey.String()).Int("utxos", len(utxos)).Str("hash", redeemTx.TxHash().String()).Msg("consolidated utxos")
+	return nil
+}
+
+func (c *Client) signAndBroadcastConsolidateTx(txOut stypes.TxOutItem, redeemTx *wire.MsgTx, sourceScript []byte, inputAmounts []int64) error {
+	if err := c.signInputs(redeemTx, txOut.VaultPubKey, sourceScript, inputAmounts); err != nil {
+		return fmt.Errorf("fail to sign consolidate tx: %w", err)
+	}
+	var signedTx bytes.Buffer
+	if err := redeemTx.Serialize(&signedTx); err != nil {
+		return fmt.Errorf("fail to serialize tx to bytes: %w", err)
+	}
+	if err := c.BroadcastTx(txOut, signedTx.Bytes()); err != nil {
+		return fmt.Errorf("fail to broadcast consolidate tx: %w", err)
+	}
+	return nil
+}
+
+// buildConsolidateTx create an unsigned tx spending all the given utxos to a single output paying to the vault itself,
+// it returns the tx and the value of the utxo spent by each input
+func buildConsolidateTx(utxos []UnspentTransactionOutput, sourceScript []byte, feeRate int64) (*wire.MsgTx, []int64, error) {
+	redeemTx := wire.NewMsgTx(wire.TxVersion)
+	individualAmounts := make(map[string]int64, len(utxos))
+	total := int64(0)
+	for _, item := range utxos {
+		outputPoint := wire.NewOutPoint(&item.TxID, item.N)
+		sourceTxIn := wire.NewTxIn(outputPoint, nil, nil)
+		sourceTxIn.Sequence = rbfSequenceNum
+		redeemTx.AddTxIn(sourceTxIn)
+		amt, err := btcutil.NewAmount(item.Value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("fail to parse amount(%f): %w", item.Value, err)
+		}
+		individualAmounts[outputPoint.String()] = int64(amt)
+		total += int64(amt)
+	}
+	redeemTx.AddTxOut(wire.NewTxOut(total, sourceScript))
+	txsort.InPlaceSort(redeemTx)
+	// the memo is added after sorting, the observer expects the first output to be the one with value
+	nullDataScript, err := txscript.NullDataScript([]byte(ConsolidateMemo))
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to generate null data script: %w", err)
+	}
+	redeemTx.AddTxOut(wire.NewTxOut(0, nullDataScript))
+
+	vSize := mempool.GetTxVirtualSize(btcutil.NewTx(redeemTx)) + int64(len(redeemTx.TxIn))*witnessVSize
+	fee := vSize * feeRate
+	if total <= fee {
+		return nil, nil, fmt.Errorf("utxos total(%d) can't pay the fee(%d)", total, fee)
+	}
+	redeemTx.TxOut[0].Value = total - fee
+
+	inputAmounts := make([]int64, len(redeemTx.TxIn))
+	for idx, txIn := range redeemTx.TxIn {
+		inputAmounts[idx] = individualAmounts[txIn.PreviousOutPoint.String()]
+	}
+	return redeemTx, inputAmounts, nil
+}
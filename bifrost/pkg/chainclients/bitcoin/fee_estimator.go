@@ -0,0 +1,135 @@
+package bitcoin
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcutil"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+const (
+	// FeeRateBlocks the number of recent blocks whose median fee rate is taken into account when estimating the fee rate
+	FeeRateBlocks = 6
+	// FeeRateConfTarget the number of blocks an outbound tx should be confirmed within, used to query estimatesmartfee
+	FeeRateConfTarget = 2
+	// EstimateAverageTxSize the estimated size (in vbytes) of an outbound tx, reported to THORChain together with the fee rate
+	EstimateAverageTxSize = 250
+	// FeeRateReportInterval the number of blocks after which an unchanged fee rate is reported again, so it doesn't expire on THORChain
+	FeeRateReportInterval = 3
+)
+
+// getBlockFeeRate returns the median fee rate (sats per vbyte) paid by the txs in the block at the given height
+func (c *Client) getBlockFeeRate(height int64) (int64, error) {
+	stats, err := c.client.GetBlockStats(height, &[]string{"feerate_percentiles"})
+	if err != nil {
+		return 0, fmt.Errorf("fail to get block stats: %w", err)
+	}
+	// the percentiles are the 10th, 25th, 50th, 75th and 90th
+	if len(stats.FeeratePercentiles) != 5 {
+		return 0, fmt.Errorf("unexpected number of fee rate percentiles(%d)", len(stats.FeeratePercentiles))
+	}
+	return stats.FeeratePercentiles[2], nil
+}
+
+// getRecentBlocksFeeRate returns the median of the fee rates of the last FeeRateBlocks blocks in local storage
+func (c *Client) getRecentBlocksFeeRate() (int64, error) {
+	blockMetas, err := c.blockMetaAccessor.GetBlockMetas()
+	if err != nil {
+		return 0, fmt.Errorf("fail to get block metas: %w", err)
+	}
+	sort.SliceStable(blockMetas, func(i, j int) bool {
+		return blockMetas[i].Height > blockMetas[j].Height
+	})
+	feeRates := make([]int64, 0, FeeRateBlocks)
+	for _, b := range blockMetas {
+		if len(feeRates) == FeeRateBlocks {
+			break
+		}
+		if b.FeeRate <= 0 {
+			continue
+		}
+		feeRates = append(feeRates, b.FeeRate)
+	}
+	if len(feeRates) == 0 {
+		return 0, errors.New("no fee rate in local storage")
+	}
+	sort.Slice(feeRates, func(i, j int) bool { return feeRates[i] < feeRates[j] })
+	return feeRates[len(feeRates)/2], nil
+}
+
+// getSmartFeeRate returns the fee rate (sats per vbyte) estimated by the bitcoin node for a tx to confirm within FeeRateConfTarget blocks
+func (c *Client) getSmartFeeRate() (int64, error) {
+	mode := btcjson.EstimateModeConservative
+	result, err := c.client.EstimateSmartFee(FeeRateConfTarget, &mode)
+	if err != nil {
+		return 0, fmt.Errorf("fail to estimate smart fee: %w", err)
+	}
+	if result.FeeRate == nil {
+		return 0, fmt.Errorf("bitcoin node can't estimate fee rate: %v", result.Errors)
+	}
+	// the fee rate is in BTC per kvbyte
+	amt, err := btcutil.NewAmount(*result.FeeRate)
+	if err != nil {
+		return 0, fmt.Errorf("fail to parse fee rate(%f): %w", *result.FeeRate, err)
+	}
+	return int64(amt) / 1000, nil
+}
+
+// estimateFeeRate returns the fee rate (sats per vbyte) an outbound tx should pay, it takes the higher of the median fee rate
+// of the recent blocks and the fee rate estimated by the bitcoin node, so the tx still get confirmed when the mempool is filling up
+func (c *Client) estimateFeeRate() (int64, error) {
+	blocksFeeRate, blocksErr := c.getRecentBlocksFeeRate()
+	if blocksErr != nil {
+		c.logger.Debug().Err(blocksErr).Msg("fail to get fee rate of recent blocks")
+	}
+	smartFeeRate, smartErr := c.getSmartFeeRate()
+	if smartErr != nil {
+		c.logger.Debug().Err(smartErr).Msg("fail to get smart fee rate")
+	}
+	if blocksErr != nil && smartErr != nil {
+		return 0, fmt.Errorf("fail to estimate fee rate: %w", smartErr)
+	}
+	feeRate := blocksFeeRate
+	if smartFeeRate > feeRate {
+		feeRate = smartFeeRate
+	}
+	if feeRate < minRelayFeeRate {
+		feeRate = minRelayFeeRate
+	}
+	return feeRate, nil
+}
+
+// reportNetworkFee send the estimated fee rate to THORChain when it changed, or every FeeRateReportInterval blocks, THORChain
+// use the median of the recent fee rates reported by the active nodes as the fee rate every outbound tx pays
+func (c *Client) reportNetworkFee(height int64) {
+	tip, err := c.client.GetBlockCount()
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get block height")
+		return
+	}
+	// the fee rate of old blocks doesn't matter while catching up
+	if height < tip {
+		return
+	}
+	feeRate, err := c.estimateFeeRate()
+	if err != nil {
+		c.logger.Err(err).Msg("fail to estimate fee rate")
+		return
+	}
+	if feeRate == atomic.LoadInt64(&c.lastFeeRate) && height < atomic.LoadInt64(&c.lastFeeRateHeight)+FeeRateReportInterval {
+		return
+	}
+	txID, err := c.bridge.PostNetworkFee(height, common.BTCChain, EstimateAverageTxSize, uint64(feeRate))
+	if err != nil {
+		c.logger.Err(err).Msg("fail to post network fee to thorchain")
+		return
+	}
+	atomic.StoreInt64(&c.lastFeeRate, feeRate)
+	atomic.StoreInt64(&c.lastFeeRateHeight, height)
+	c.logger.Info().Str("txid", txID.String()).Int64("fee_rate", feeRate).Msg("sent network fee to THORChain")
+}
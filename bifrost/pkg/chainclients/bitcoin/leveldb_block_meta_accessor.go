@@ -10,8 +10,8 @@ import (
 
 // PrefixUTXOStorage declares prefix to use in leveldb to avoid conflicts
 const (
-	TransactionFeeKey = "transactionfee-"
-	PrefixBlocMeta    = `blockmeta-`
+	PrefixBlocMeta = `blockmeta-`
+	PrefixSignedTx = `signedtx-`
 )
 
 // LevelDBBlockMetaAccessor struct
@@ -114,28 +114,39 @@ func (t *LevelDBBlockMetaAccessor) PruneBlockMeta(height int64) error {
 	return nil
 }
 
-// UpsertTransactionFee update the transaction fee in storage
-func (t *LevelDBBlockMetaAccessor) UpsertTransactionFee(fee float64, vSize int32) error {
-	transactionFee := TransactionFee{
-		Fee:   fee,
-		VSize: vSize,
-	}
-	buf, err := json.Marshal(transactionFee)
-	if err != nil {
-		return fmt.Errorf("fail to marshal transaction fee struct to json: %w", err)
-	}
-	return t.db.Put([]byte(TransactionFeeKey), buf, nil)
+func (t *LevelDBBlockMetaAccessor) getSignedTxKey(txID string) string {
+	return PrefixSignedTx + txID
 }
 
-// GetTransactionFee from db
-func (t *LevelDBBlockMetaAccessor) GetTransactionFee() (float64, int32, error) {
-	buf, err := t.db.Get([]byte(TransactionFeeKey), nil)
+// UpsertSignedTx save the given signed tx into storage
+func (t *LevelDBBlockMetaAccessor) UpsertSignedTx(signedTx SignedTx) error {
+	buf, err := json.Marshal(signedTx)
 	if err != nil {
-		return 0.0, 0, fmt.Errorf("fail to get transaction fee from storage: %w", err)
+		return fmt.Errorf("fail to marshal signed tx to json: %w", err)
 	}
-	var transactionFee TransactionFee
-	if err := json.Unmarshal(buf, &transactionFee); err != nil {
-		return 0.0, 0, fmt.Errorf("fail to unmarshal transaction fee: %w", err)
+	return t.db.Put([]byte(t.getSignedTxKey(signedTx.TxID)), buf, nil)
+}
+
+// GetSignedTxs returns all the signed txs in storage
+func (t *LevelDBBlockMetaAccessor) GetSignedTxs() ([]SignedTx, error) {
+	signedTxs := make([]SignedTx, 0)
+	iterator := t.db.NewIterator(util.BytesPrefix([]byte(PrefixSignedTx)), nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		buf := iterator.Value()
+		if len(buf) == 0 {
+			continue
+		}
+		var signedTx SignedTx
+		if err := json.Unmarshal(buf, &signedTx); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal signed tx: %w", err)
+		}
+		signedTxs = append(signedTxs, signedTx)
 	}
-	return transactionFee.Fee, transactionFee.VSize, nil
+	return signedTxs, nil
+}
+
+// RemoveSignedTx remove the signed tx with the given tx id from storage
+func (t *LevelDBBlockMetaAccessor) RemoveSignedTx(txID string) error {
+	return t.db.Delete([]byte(t.getSignedTxKey(txID)), nil)
 }
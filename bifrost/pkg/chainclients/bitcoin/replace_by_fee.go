@@ -0,0 +1,320 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+
+	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
+	"gitlab.com/thorchain/thornode/common"
+)
+
+const (
+	// RBFBlocks the number of blocks an outbound tx can stay unconfirmed before it is replaced with a higher fee
+	RBFBlocks = 3
+	// MaxRBFReplacements the maximum number of times an outbound tx will be replaced
+	MaxRBFReplacements = 5
+	// minRelayFeeRate the minimum fee rate (sats per vbyte) a replacement need to pay on top of the fee of the tx it replaces
+	minRelayFeeRate = 1
+	// MaxRBFFeeMultiplier a replacement never pays more fee than this many times the max gas of the outbound
+	MaxRBFFeeMultiplier = 3
+)
+
+// getInputAmounts returns the value of the utxo spent by each input of the given tx
+func (c *Client) getInputAmounts(tx *wire.MsgTx) ([]int64, error) {
+	blockMetas, err := c.blockMetaAccessor.GetBlockMetas()
+	if err != nil {
+		return nil, fmt.Errorf("fail to get block metas: %w", err)
+	}
+	values := make(map[string]float64)
+	for _, blockMeta := range blockMetas {
+		for _, utxo := range blockMeta.UnspentTransactionOutputs {
+			values[utxo.GetKey()] = utxo.Value
+		}
+	}
+	amounts := make([]int64, len(tx.TxIn))
+	for idx, in := range tx.TxIn {
+		key := in.PreviousOutPoint.String()
+		value, ok := values[key]
+		if !ok {
+			return nil, fmt.Errorf("fail to find utxo(%s)", key)
+		}
+		amt, err := btcutil.NewAmount(value)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse amount(%f): %w", value, err)
+		}
+		amounts[idx] = int64(amt)
+	}
+	return amounts, nil
+}
+
+// recordSignedTx keep the broadcast tx in storage until it is confirmed
+func (c *Client) recordSignedTx(txOut stypes.TxOutItem, tx *wire.MsgTx, height int64) error {
+	inputAmounts, err := c.getInputAmounts(tx)
+	if err != nil {
+		return fmt.Errorf("fail to get input amounts: %w", err)
+	}
+	signedTx, err := NewSignedTx(txOut, tx, inputAmounts, height)
+	if err != nil {
+		return fmt.Errorf("fail to create signed tx: %w", err)
+	}
+	return c.blockMetaAccessor.UpsertSignedTx(signedTx)
+}
+
+// processSignedTxs go through the outbound txs waiting for confirmation, forget the ones confirmed in the given block,
+// and returns the ones that had been stuck for RBFBlocks blocks, to be replaced with a higher fee
+func (c *Client) processSignedTxs(block *btcjson.GetBlockVerboseTxResult) []SignedTx {
+	signedTxs, err := c.blockMetaAccessor.GetSignedTxs()
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get signed txs from storage")
+		return nil
+	}
+	if len(signedTxs) == 0 {
+		return nil
+	}
+	var stuck []SignedTx
+	// the tx spending each utxo in this block
+	spentBy := make(map[string]*btcjson.TxRawResult)
+	for i, tx := range block.Tx {
+		for _, in := range tx.Vin {
+			spentBy[fmt.Sprintf("%s:%d", in.Txid, in.Vout)] = &block.Tx[i]
+		}
+	}
+	for _, signedTx := range signedTxs {
+		redeemTx, err := signedTx.GetMsgTx()
+		if err != nil {
+			c.logger.Err(err).Str("tx_id", signedTx.TxID).Msg("fail to get signed tx")
+			continue
+		}
+		if len(redeemTx.TxIn) == 0 {
+			continue
+		}
+		// all the versions of a replaced tx spend the same utxos, whichever of them got confirmed
+		if confirmedTx, ok := spentBy[redeemTx.TxIn[0].PreviousOutPoint.String()]; ok {
+			if confirmedTx.Txid != signedTx.TxID {
+				if err := c.confirmReplacedTx(signedTx, redeemTx, confirmedTx); err != nil {
+					c.logger.Err(err).Str("tx_id", confirmedTx.Txid).Msg("fail to update change utxo of confirmed tx")
+				}
+			}
+			if err := c.blockMetaAccessor.RemoveSignedTx(signedTx.TxID); err != nil {
+				c.logger.Err(err).Str("tx_id", signedTx.TxID).Msg("fail to remove signed tx from storage")
+			}
+			continue
+		}
+		if block.Height-signedTx.BroadcastHeight < RBFBlocks {
+			continue
+		}
+		if signedTx.Replaced >= MaxRBFReplacements {
+			c.logger.Warn().Str("tx_id", signedTx.TxID).Msgf("tx is still unconfirmed after %d replacements", signedTx.Replaced)
+			continue
+		}
+		stuck = append(stuck, signedTx)
+	}
+	return stuck
+}
+
+// replaceStuckTxs replace the given stuck txs with a higher fee, every replacement is a TSS keysign, so it runs in
+// the background to not hold up block scanning, the next stuck txs are picked up once the previous ones are done
+func (c *Client) replaceStuckTxs(stuck []SignedTx, height int64) {
+	if !atomic.CompareAndSwapInt32(&c.replacing, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&c.replacing, 0)
+	for _, signedTx := range stuck {
+		redeemTx, err := signedTx.GetMsgTx()
+		if err != nil {
+			c.logger.Err(err).Str("tx_id", signedTx.TxID).Msg("fail to get signed tx")
+			continue
+		}
+		if err := c.replaceSignedTx(signedTx, redeemTx, height); err != nil {
+			c.logger.Err(err).Str("tx_id", signedTx.TxID).Msg("fail to replace stuck tx")
+		}
+	}
+}
+
+// getChangeOutputIndex returns the index of the output paying back to the vault, -1 when there is none
+func getChangeOutputIndex(tx *wire.MsgTx, changeScript []byte) int {
+	for idx, out := range tx.TxOut {
+		if bytes.Equal(out.PkScript, changeScript) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// bumpFee returns a copy of the given tx that pay a higher fee, without witness. The fee is increased by half
+// and at least by minRelayFeeRate per vbyte as required by BIP125, but never above maxFee, the extra fee is taken
+// from the change output. The replacement only depends on the given tx, so every signer build the same one
+func bumpFee(tx *wire.MsgTx, inputAmounts []int64, changeScript []byte, maxFee int64) (*wire.MsgTx, error) {
+	fee := int64(0)
+	for _, amount := range inputAmounts {
+		fee += amount
+	}
+	for _, out := range tx.TxOut {
+		fee -= out.Value
+	}
+	extraFee := fee / 2
+	vSize := mempool.GetTxVirtualSize(btcutil.NewTx(tx))
+	minExtraFee := vSize * minRelayFeeRate
+	if extraFee < minExtraFee {
+		extraFee = minExtraFee
+	}
+	if fee+extraFee > maxFee {
+		extraFee = maxFee - fee
+	}
+	if extraFee < minExtraFee {
+		return nil, fmt.Errorf("fee(%d) can't be bumped without going over the max fee(%d)", fee, maxFee)
+	}
+
+	replacement := tx.Copy()
+	idx := getChangeOutputIndex(replacement, changeScript)
+	if idx < 0 {
+		return nil, errors.New("no change output to pay the extra fee")
+	}
+	if replacement.TxOut[idx].Value <= extraFee {
+		return nil, fmt.Errorf("change(%d) is not enough to pay the extra fee(%d)", replacement.TxOut[idx].Value, extraFee)
+	}
+	replacement.TxOut[idx].Value -= extraFee
+	for _, in := range replacement.TxIn {
+		in.Witness = nil
+	}
+	return replacement, nil
+}
+
+// replaceSignedTx sign and broadcast a new version of the given stuck tx that pay a higher fee
+// the fee actually paid is reported to THORChain as the gas of the observed outbound
+func (c *Client) replaceSignedTx(signedTx SignedTx, redeemTx *wire.MsgTx, height int64) error {
+	changeScript, err := c.getSourceScript(signedTx.TxOutItem)
+	if err != nil {
+		return fmt.Errorf("fail to get change script: %w", err)
+	}
+	// BIP125 requires a replacement to pay for the descendants it evicts too, a yggdrasil vault can spend
+	// unconfirmed change, so the tx is left alone once anything spends its change
+	idx := getChangeOutputIndex(redeemTx, changeScript)
+	changeKey := fmt.Sprintf("%s:%d", signedTx.TxID, idx)
+	change, err := c.getUTXO(changeKey)
+	if err != nil {
+		return fmt.Errorf("fail to get change utxo: %w", err)
+	}
+	if change == nil || change.Spent {
+		return fmt.Errorf("change utxo(%s) is spent or unknown, can't replace the tx", changeKey)
+	}
+	if entry, err := c.client.GetMempoolEntry(signedTx.TxID); err == nil && entry.DescendantCount > 1 {
+		return fmt.Errorf("tx has %d descendants in mempool, can't replace it", entry.DescendantCount-1)
+	}
+	maxGas := signedTx.TxOutItem.MaxGas.ToCoins().GetCoin(common.BTCAsset).Amount.Uint64()
+	if maxGas == 0 {
+		return errors.New("tx has no max gas to cap the fee of its replacement")
+	}
+	replacement, err := bumpFee(redeemTx, signedTx.InputAmounts, changeScript, int64(maxGas)*MaxRBFFeeMultiplier)
+	if err != nil {
+		return fmt.Errorf("fail to bump fee: %w", err)
+	}
+	if err := c.signInputs(replacement, signedTx.TxOutItem.VaultPubKey, changeScript, signedTx.InputAmounts); err != nil {
+		return fmt.Errorf("fail to sign replacement tx: %w", err)
+	}
+	replacementHash := replacement.TxHash()
+	if _, err := c.client.SendRawTransaction(replacement, true); err != nil {
+		// another signer might had broadcast the same replacement already
+		if _, mempoolErr := c.client.GetMempoolEntry(replacementHash.String()); mempoolErr != nil {
+			return fmt.Errorf("fail to broadcast replacement tx: %w", err)
+		}
+	}
+
+	newIdx := getChangeOutputIndex(replacement, changeScript)
+	newChange := NewUnspentTransactionOutput(replacementHash, uint32(newIdx), btcutil.Amount(replacement.TxOut[newIdx].Value).ToBTC(), 0, signedTx.TxOutItem.VaultPubKey)
+	if err := c.replaceChangeUTXO(changeKey, &newChange); err != nil {
+		return fmt.Errorf("fail to replace change utxo: %w", err)
+	}
+
+	replaced, err := NewSignedTx(signedTx.TxOutItem, replacement, signedTx.InputAmounts, height)
+	if err != nil {
+		return fmt.Errorf("fail to create signed tx: %w", err)
+	}
+	replaced.Replaced = signedTx.Replaced + 1
+	if err := c.blockMetaAccessor.UpsertSignedTx(replaced); err != nil {
+		return fmt.Errorf("fail to save replacement tx: %w", err)
+	}
+	if err := c.blockMetaAccessor.RemoveSignedTx(signedTx.TxID); err != nil {
+		return fmt.Errorf("fail to remove replaced tx: %w", err)
+	}
+	c.logger.Info().Str("tx_id", signedTx.TxID).Str("replacement", replacementHash.String()).Msg("replace stuck tx with a higher fee")
+	return nil
+}
+
+// confirmReplacedTx update the change utxo when another version of the signed tx got confirmed
+func (c *Client) confirmReplacedTx(signedTx SignedTx, redeemTx *wire.MsgTx, confirmedTx *btcjson.TxRawResult) error {
+	changeScript, err := c.getSourceScript(signedTx.TxOutItem)
+	if err != nil {
+		return fmt.Errorf("fail to get change script: %w", err)
+	}
+	confirmed, err := c.getMsgTx(confirmedTx)
+	if err != nil {
+		return err
+	}
+	var change *UnspentTransactionOutput
+	if idx := getChangeOutputIndex(confirmed, changeScript); idx >= 0 {
+		utxo := NewUnspentTransactionOutput(confirmed.TxHash(), uint32(idx), btcutil.Amount(confirmed.TxOut[idx].Value).ToBTC(), 0, signedTx.TxOutItem.VaultPubKey)
+		change = &utxo
+	}
+	idx := getChangeOutputIndex(redeemTx, changeScript)
+	return c.replaceChangeUTXO(fmt.Sprintf("%s:%d", signedTx.TxID, idx), change)
+}
+
+// getMsgTx decode the raw tx of the given tx result
+func (c *Client) getMsgTx(tx *btcjson.TxRawResult) (*wire.MsgTx, error) {
+	buf, err := hex.DecodeString(tx.Hex)
+	if err != nil {
+		return nil, fmt.Errorf("fail to decode tx hex: %w", err)
+	}
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	if err := msgTx.Deserialize(bytes.NewBuffer(buf)); err != nil {
+		return nil, fmt.Errorf("fail to deserialize tx: %w", err)
+	}
+	return msgTx, nil
+}
+
+// getUTXO returns the utxo of the given key in storage, nil when there is none
+func (c *Client) getUTXO(key string) (*UnspentTransactionOutput, error) {
+	blockMetas, err := c.blockMetaAccessor.GetBlockMetas()
+	if err != nil {
+		return nil, fmt.Errorf("fail to get block metas: %w", err)
+	}
+	for _, blockMeta := range blockMetas {
+		for _, utxo := range blockMeta.UnspentTransactionOutputs {
+			if utxo.GetKey() == key {
+				return &utxo, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// replaceChangeUTXO swap the change utxo of a replaced tx with the change utxo of the tx replacing it
+func (c *Client) replaceChangeUTXO(oldKey string, change *UnspentTransactionOutput) error {
+	blockMetas, err := c.blockMetaAccessor.GetBlockMetas()
+	if err != nil {
+		return fmt.Errorf("fail to get block metas: %w", err)
+	}
+	for _, blockMeta := range blockMetas {
+		for _, utxo := range blockMeta.UnspentTransactionOutputs {
+			if utxo.GetKey() != oldKey {
+				continue
+			}
+			blockMeta.RemoveUTXO(oldKey)
+			if change != nil {
+				change.BlockHeight = utxo.BlockHeight
+				blockMeta.AddUTXO(*change)
+			}
+			return c.blockMetaAccessor.SaveBlockMeta(blockMeta.Height, blockMeta)
+		}
+	}
+	return fmt.Errorf("fail to find change utxo(%s)", oldKey)
+}
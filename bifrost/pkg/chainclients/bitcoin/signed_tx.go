@@ -0,0 +1,45 @@
+package bitcoin
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+
+	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
+)
+
+// SignedTx is an outbound tx broadcast by the chain client, it is kept in storage until it is confirmed
+// so it can be replaced with a higher fee when it get stuck in mempool
+type SignedTx struct {
+	TxID            string           `json:"tx_id"`
+	TxOutItem       stypes.TxOutItem `json:"tx_out_item"`
+	Payload         []byte           `json:"payload"`
+	InputAmounts    []int64          `json:"input_amounts"`
+	BroadcastHeight int64            `json:"broadcast_height"`
+	Replaced        int64            `json:"replaced"`
+}
+
+// NewSignedTx create a new instance of SignedTx
+func NewSignedTx(txOut stypes.TxOutItem, tx *wire.MsgTx, inputAmounts []int64, height int64) (SignedTx, error) {
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return SignedTx{}, fmt.Errorf("fail to serialize tx: %w", err)
+	}
+	return SignedTx{
+		TxID:            tx.TxHash().String(),
+		TxOutItem:       txOut,
+		Payload:         buf.Bytes(),
+		InputAmounts:    inputAmounts,
+		BroadcastHeight: height,
+	}, nil
+}
+
+// GetMsgTx deserialize the signed tx
+func (s SignedTx) GetMsgTx() (*wire.MsgTx, error) {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	if err := tx.Deserialize(bytes.NewBuffer(s.Payload)); err != nil {
+		return nil, fmt.Errorf("fail to deserialize signed tx: %w", err)
+	}
+	return tx, nil
+}
@@ -5,11 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"sync"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg"
-	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/mempool"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
@@ -25,10 +25,12 @@ import (
 )
 
 const (
-	// SatsPervBytes it should be enough , this one will only be used if signer can't find any previous UTXO , and fee info from local storage.
+	// SatsPervBytes it should be enough , this one will only be used if signer can't estimate the fee rate
 	SatsPervBytes = 25
 	// MinUTXOConfirmation UTXO that has less confirmation then this will not be spent , unless it is yggdrasil
 	MinUTXOConfirmation = 10
+	// rbfSequenceNum the sequence number of every input of outbound tx, it signals the tx can be replaced with a higher fee (BIP125)
+	rbfSequenceNum = wire.MaxTxInSequenceNum - 2
 )
 
 func getBTCPrivateKey(key crypto.PrivKey) (*btcec.PrivateKey, error) {
@@ -53,23 +55,18 @@ func (c *Client) getChainCfg() *chaincfg.Params {
 	return nil
 }
 
+// getGasCoin returns the fee the outbound tx of the given vsize pays, at the fee rate agreed on THORChain when there is one
 func (c *Client) getGasCoin(tx stypes.TxOutItem, vSize int64) common.Coin {
+	if tx.GasRate > 0 {
+		return common.NewCoin(common.BTCAsset, sdk.NewUint(uint64(tx.GasRate*vSize)))
+	}
 	if !tx.MaxGas.IsEmpty() {
 		return tx.MaxGas.ToCoins().GetCoin(common.BTCAsset)
 	}
-	gasRate := int64(SatsPervBytes)
-	fee, vBytes, err := c.blockMetaAccessor.GetTransactionFee()
+	gasRate, err := c.estimateFeeRate()
 	if err != nil {
-		c.logger.Error().Err(err).Msg("fail to get previous transaction fee from local storage")
-		return common.NewCoin(common.BTCAsset, sdk.NewUint(uint64(vSize*gasRate)))
-	}
-	if fee != 0.0 && vSize != 0 {
-		amt, err := btcutil.NewAmount(fee)
-		if err != nil {
-			c.logger.Err(err).Msg("fail to convert amount from float64 to int64")
-		} else {
-			gasRate = int64(amt) / int64(vBytes) // sats per vbyte
-		}
+		c.logger.Err(err).Msg("fail to estimate fee rate")
+		gasRate = SatsPervBytes
 	}
 	return common.NewCoin(common.BTCAsset, sdk.NewUint(uint64(gasRate*vSize)))
 }
@@ -187,18 +184,19 @@ func (c *Client) SignTx(tx stypes.TxOutItem, thorchainHeight int64) ([]byte, err
 	}
 	redeemTx := wire.NewMsgTx(wire.TxVersion)
 	totalAmt := float64(0)
-	individualAmounts := make(map[chainhash.Hash]btcutil.Amount, len(txes))
+	individualAmounts := make(map[string]btcutil.Amount, len(txes))
 	for _, item := range txes {
 		// double check that the utxo is still valid
 		outputPoint := wire.NewOutPoint(&item.TxID, item.N)
 		sourceTxIn := wire.NewTxIn(outputPoint, nil, nil)
+		sourceTxIn.Sequence = rbfSequenceNum
 		redeemTx.AddTxIn(sourceTxIn)
 		totalAmt += item.Value
 		amt, err := btcutil.NewAmount(item.Value)
 		if err != nil {
 			return nil, fmt.Errorf("fail to parse amount(%f): %w", item.Value, err)
 		}
-		individualAmounts[item.TxID] = amt
+		individualAmounts[outputPoint.String()] = amt
 	}
 
 	outputAddr, err := btcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfg())
@@ -214,12 +212,6 @@ func (c *Client) SignTx(tx stypes.TxOutItem, thorchainHeight int64) ([]byte, err
 	if err != nil {
 		return nil, fmt.Errorf("fail to parse total amount(%f),err: %w", totalAmt, err)
 	}
-	vSize := mempool.GetTxVirtualSize(btcutil.NewTx(redeemTx))
-	gasCoin := c.getGasCoin(tx, vSize)
-	gasAmt := btcutil.Amount(int64(gasCoin.Amount.Uint64()))
-	if err := c.blockMetaAccessor.UpsertTransactionFee(gasAmt.ToBTC(), int32(vSize)); err != nil {
-		c.logger.Err(err).Msg("fail to save gas info to UTXO storage")
-	}
 	coinToCustomer := tx.Coins.GetCoin(common.BTCAsset)
 
 	// pay to customer
@@ -234,6 +226,9 @@ func (c *Client) SignTx(tx stypes.TxOutItem, thorchainHeight int64) ([]byte, err
 		}
 		redeemTx.AddTxOut(wire.NewTxOut(0, nullDataScript))
 	}
+	// the size of the signed tx, with the witness of every input and the output paying the balance back to ourselves
+	vSize := mempool.GetTxVirtualSize(btcutil.NewTx(redeemTx)) + int64(len(redeemTx.TxIn))*witnessVSize + int64(wire.NewTxOut(0, sourceScript).SerializeSize())
+	gasCoin := c.getGasCoin(tx, vSize)
 	// balance to ourselves
 	// add output to pay the balance back ourselves
 	balance := int64(total) - redeemTxOut.Value - int64(gasCoin.Amount.Uint64())
@@ -246,40 +241,28 @@ func (c *Client) SignTx(tx stypes.TxOutItem, thorchainHeight int64) ([]byte, err
 	// sort inputs and outputs
 	txsort.InPlaceSort(redeemTx)
 
+	inputAmounts := make([]int64, len(redeemTx.TxIn))
 	for idx, txIn := range redeemTx.TxIn {
-		sigHashes := txscript.NewTxSigHashes(redeemTx)
-		sig := c.ksWrapper.GetSignable(tx.VaultPubKey)
-		outputAmount := int64(individualAmounts[txIn.PreviousOutPoint.Hash])
-		witness, err := txscript.WitnessSignature(redeemTx, sigHashes, idx, outputAmount, sourceScript, txscript.SigHashAll, sig, true)
-		if err != nil {
-			var keysignError tss.KeysignError
-			if errors.As(err, &keysignError) {
-				if len(keysignError.Blame.BlameNodes) == 0 {
-					// TSS doesn't know which node to blame
-					return nil, err
-				}
-
-				// key sign error forward the keysign blame to thorchain
-				txID, err := c.bridge.PostKeysignFailure(keysignError.Blame, thorchainHeight, tx.Memo, tx.Coins)
-				if err != nil {
-					c.logger.Error().Err(err).Msg("fail to post keysign failure to thorchain")
-					return nil, err
-				}
-				c.logger.Info().Str("tx_id", txID.String()).Msgf("post keysign failure to thorchain")
-				return nil, fmt.Errorf("sent keysign failure to thorchain")
+		inputAmounts[idx] = int64(individualAmounts[txIn.PreviousOutPoint.String()])
+	}
+	if err := c.signInputs(redeemTx, tx.VaultPubKey, sourceScript, inputAmounts); err != nil {
+		var keysignError tss.KeysignError
+		if errors.As(err, &keysignError) {
+			if len(keysignError.Blame.BlameNodes) == 0 {
+				// TSS doesn't know which node to blame
+				return nil, err
 			}
-			return nil, fmt.Errorf("fail to get witness: %w", err)
-		}
 
-		redeemTx.TxIn[idx].Witness = witness
-		flag := txscript.StandardVerifyFlags
-		engine, err := txscript.NewEngine(sourceScript, redeemTx, idx, flag, nil, nil, outputAmount)
-		if err != nil {
-			return nil, fmt.Errorf("fail to create engine: %w", err)
-		}
-		if err := engine.Execute(); err != nil {
-			return nil, fmt.Errorf("fail to execute the script: %w", err)
+			// key sign error forward the keysign blame to thorchain
+			txID, err := c.bridge.PostKeysignFailure(keysignError.Blame, thorchainHeight, tx.Memo, tx.Coins)
+			if err != nil {
+				c.logger.Error().Err(err).Msg("fail to post keysign failure to thorchain")
+				return nil, err
+			}
+			c.logger.Info().Str("tx_id", txID.String()).Msgf("post keysign failure to thorchain")
+			return nil, fmt.Errorf("sent keysign failure to thorchain: %w", keysignError)
 		}
+		return nil, err
 	}
 
 	var signedTx bytes.Buffer
@@ -289,6 +272,40 @@ func (c *Client) SignTx(tx stypes.TxOutItem, thorchainHeight int64) ([]byte, err
 	return signedTx.Bytes(), nil
 }
 
+// signInputs sign all the inputs of the given tx, inputAmounts hold the value of the utxo spent by each input
+// the inputs are signed concurrently, TSS takes a single message per keysign, so each input still needs a keysign round of its own
+func (c *Client) signInputs(redeemTx *wire.MsgTx, vaultPubKey common.PubKey, sourceScript []byte, inputAmounts []int64) error {
+	sigHashes := txscript.NewTxSigHashes(redeemTx)
+	witnesses := make([]wire.TxWitness, len(redeemTx.TxIn))
+	witnessErrs := make([]error, len(redeemTx.TxIn))
+	wg := &sync.WaitGroup{}
+	for idx := range redeemTx.TxIn {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			sig := c.ksWrapper.GetSignable(vaultPubKey)
+			witnesses[idx], witnessErrs[idx] = txscript.WitnessSignature(redeemTx, sigHashes, idx, inputAmounts[idx], sourceScript, txscript.SigHashAll, sig, true)
+		}(idx)
+	}
+	wg.Wait()
+
+	for idx := range redeemTx.TxIn {
+		if err := witnessErrs[idx]; err != nil {
+			return fmt.Errorf("fail to get witness: %w", err)
+		}
+		redeemTx.TxIn[idx].Witness = witnesses[idx]
+		flag := txscript.StandardVerifyFlags
+		engine, err := txscript.NewEngine(sourceScript, redeemTx, idx, flag, nil, nil, inputAmounts[idx])
+		if err != nil {
+			return fmt.Errorf("fail to create engine: %w", err)
+		}
+		if err := engine.Execute(); err != nil {
+			return fmt.Errorf("fail to execute the script: %w", err)
+		}
+	}
+	return nil
+}
+
 // updateBlockMeta updates block meta with broadcasting tx data
 func (c *Client) updateBlockMeta(txOut stypes.TxOutItem, blockMeta *BlockMeta, tx *wire.MsgTx) error {
 	// add new balance output as spendable utxo
@@ -426,5 +443,9 @@ func (c *Client) BroadcastTx(txOut stypes.TxOutItem, payload []byte) error {
 	}
 	// save tx id to block meta in case we need to errata later
 	c.logger.Info().Str("hash", txHash.String()).Msg("broadcast to BTC chain successfully")
+	// keep the tx until it is confirmed, so it can be replaced with a higher fee when it get stuck
+	if err := c.recordSignedTx(txOut, redeemTx, chainBlockHeight); err != nil {
+		c.logger.Err(err).Str("hash", txHash.String()).Msg("fail to record signed tx")
+	}
 	return nil
 }
@@ -1,20 +1,25 @@
 package bitcoin
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	ctypes "github.com/binance-chain/go-sdk/common/types"
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/cosmos/cosmos-sdk/client/keys"
@@ -37,12 +42,16 @@ import (
 )
 
 type BitcoinSignerSuite struct {
-	client  *Client
-	server  *httptest.Server
-	bridge  *thorclient.ThorchainBridge
-	cfg     config.ChainConfiguration
-	m       *metrics.Metrics
-	cleanup func()
+	client       *Client
+	server       *httptest.Server
+	bridge       *thorclient.ThorchainBridge
+	cfg          config.ChainConfiguration
+	m            *metrics.Metrics
+	cleanup      func()
+	asgards      []byte
+	keysignParty []byte
+	networkFee   []byte
+	tip          int64
 }
 
 var _ = Suite(&BitcoinSignerSuite{})
@@ -80,9 +89,19 @@ func (s *BitcoinSignerSuite) SetUpTest(c *C) {
 	thorKeys, err := thorclient.NewKeys(cfg.ChainHomeFolder, cfg.SignerName, cfg.SignerPasswd)
 	c.Assert(err, IsNil)
 
+	s.asgards = []byte("[]")
+	s.keysignParty = []byte("[]")
+	s.networkFee = []byte("{}")
+	s.tip = 0
 	s.server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
-		if req.RequestURI == "/thorchain/vaults/thorpub1addwnpepqts24euwrgly2vtez3zdvusmk6u3cwf8leuzj8m4ynvmv5cst7us2vltqrh/signers" {
-			_, err := rw.Write([]byte("[]"))
+		if req.RequestURI == thorclient.AsgardVault {
+			_, err := rw.Write(s.asgards)
+			c.Assert(err, IsNil)
+		} else if strings.HasPrefix(req.RequestURI, "/thorchain/vaults/") && strings.HasSuffix(req.RequestURI, "/signers") {
+			_, err := rw.Write(s.keysignParty)
+			c.Assert(err, IsNil)
+		} else if strings.HasPrefix(req.RequestURI, "/thorchain/network_fee/") {
+			_, err := rw.Write(s.networkFee)
 			c.Assert(err, IsNil)
 		} else {
 			r := struct {
@@ -101,6 +120,9 @@ func (s *BitcoinSignerSuite) SetUpTest(c *C) {
 				httpTestHandler(c, rw, "../../../../test/fixtures/btc/tx.json")
 			case "getinfo":
 				httpTestHandler(c, rw, "../../../../test/fixtures/btc/getinfo.json")
+			case "getblockcount":
+				_, err := rw.Write([]byte(`{"result":` + strconv.FormatInt(s.tip, 10) + `,"error":null,"id":null}`))
+				c.Assert(err, IsNil)
 			case "sendrawtransaction":
 				httpTestHandler(c, rw, "../../../../test/fixtures/btc/sendrawtransaction.json")
 			}
@@ -240,15 +262,77 @@ func (s *BitcoinSignerSuite) TestSignTxHappyPathWithPrivateKey(c *C) {
 	c.Assert(buf, NotNil)
 }
 
+func (s *BitcoinSignerSuite) TestSignTxWithGasRate(c *C) {
+	addr, err := types2.GetRandomPubKey().GetAddress(common.BTCChain)
+	c.Assert(err, IsNil)
+	priKeyBuf, err := hex.DecodeString("b404c5ec58116b5f0fe13464a92e46626fc5db130e418cbce98df86ffe9317c5")
+	c.Assert(err, IsNil)
+	pkey, _ := btcec.PrivKeyFromBytes(btcec.S256(), priKeyBuf)
+	c.Assert(pkey, NotNil)
+	vaultPubKey, err := GetBech32AccountPubKey(pkey)
+	c.Assert(err, IsNil)
+	txOutItem := stypes.TxOutItem{
+		Chain:       common.BTCChain,
+		ToAddress:   addr,
+		VaultPubKey: vaultPubKey,
+		Coins: common.Coins{
+			common.NewCoin(common.BTCAsset, sdk.NewUint(10)),
+		},
+		// the gas of a typical outbound, the signer pays the fee rate for the actual size instead
+		MaxGas: common.Gas{
+			common.NewCoin(common.BTCAsset, sdk.NewUint(2500)),
+		},
+		GasRate: 10,
+	}
+	blockMeta := NewBlockMeta("000000000000008a0da55afa8432af3b15c225cc7e04d32f0de912702dd9e2ae",
+		100,
+		"0000000000000068f0710c510e94bd29aa624745da43e32a1de887387306bfda")
+	for _, hash := range []string{
+		"256222fb25a9950479bb26049a2c00e75b89abbb7f0cf646c623b93e942c4c34",
+		"24ed2d26fd5d4e0e8fa86633e40faf1bdfc8d1903b1cd02855286312d48818a2",
+		"44ed2d26fd5d4e0e8fa86633e40faf1bdfc8d1903b1cd02855286312d48818a2",
+	} {
+		txHash, err := chainhash.NewHashFromStr(hash)
+		c.Assert(err, IsNil)
+		blockMeta.AddUTXO(NewUnspentTransactionOutput(*txHash, 0, 0.01, 100, vaultPubKey))
+	}
+	c.Assert(s.client.blockMetaAccessor.SaveBlockMeta(blockMeta.Height, blockMeta), IsNil)
+	ksw, err := NewKeySignWrapper(pkey, s.client.bridge, s.client.ksWrapper.tssKeyManager)
+	c.Assert(err, IsNil)
+	s.client.privateKey = pkey
+	s.client.ksWrapper = ksw
+	buf, err := s.client.SignTx(txOutItem, 1)
+	c.Assert(err, IsNil)
+	c.Assert(buf, NotNil)
+
+	signedTx := wire.NewMsgTx(wire.TxVersion)
+	c.Assert(signedTx.Deserialize(bytes.NewReader(buf)), IsNil)
+	c.Assert(signedTx.TxIn, HasLen, 3)
+	fee := int64(3 * 1000000)
+	for _, out := range signedTx.TxOut {
+		fee -= out.Value
+	}
+	vSize := mempool.GetTxVirtualSize(btcutil.NewTx(signedTx))
+	c.Check(fee > 2500, Equals, true)
+	c.Check(fee%10, Equals, int64(0))
+	// the fee is worked out on the estimated size of the signed tx
+	c.Check(fee >= (vSize-3)*10, Equals, true, Commentf("fee: %d, vsize: %d", fee, vSize))
+	c.Check(fee <= (vSize+3)*10, Equals, true, Commentf("fee: %d, vsize: %d", fee, vSize))
+}
+
 func (s *BitcoinSignerSuite) TestSignTxWithTSS(c *C) {
-	pubkey, err := common.NewPubKey("thorpub1addwnpepqts24euwrgly2vtez3zdvusmk6u3cwf8leuzj8m4ynvmv5cst7us2vltqrh")
+	// the TSS mock sign for the vault of this private key
+	priKeyBuf, err := hex.DecodeString("b404c5ec58116b5f0fe13464a92e46626fc5db130e418cbce98df86ffe9317c5")
+	c.Assert(err, IsNil)
+	pkey, _ := btcec.PrivKeyFromBytes(btcec.S256(), priKeyBuf)
+	pubkey, err := GetBech32AccountPubKey(pkey)
 	c.Assert(err, IsNil)
-	addr, err := pubkey.GetAddress(common.BTCChain)
+	addr, err := types2.GetRandomPubKey().GetAddress(common.BTCChain)
 	c.Assert(err, IsNil)
 	txOutItem := stypes.TxOutItem{
 		Chain:       common.BTCChain,
 		ToAddress:   addr,
-		VaultPubKey: "thorpub1addwnpepqts24euwrgly2vtez3zdvusmk6u3cwf8leuzj8m4ynvmv5cst7us2vltqrh",
+		VaultPubKey: pubkey,
 		SeqNo:       0,
 		Coins: common.Coins{
 			common.NewCoin(common.BTCAsset, sdk.NewUint(10)),
@@ -356,3 +440,286 @@ func (s *BitcoinSignerSuite) TestGetAllUTXOs(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(allmetas, HasLen, 148)
 }
+
+func (s *BitcoinSignerSuite) TestBumpFee(c *C) {
+	changeScript := []byte("change")
+	tx := wire.NewMsgTx(wire.TxVersion)
+	txHash, err := chainhash.NewHashFromStr("256222fb25a9950479bb26049a2c00e75b89abbb7f0cf646c623b93e942c4c34")
+	c.Assert(err, IsNil)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(txHash, 0), nil, [][]byte{[]byte("witness")}))
+	tx.AddTxOut(wire.NewTxOut(5000, []byte("customer")))
+	tx.AddTxOut(wire.NewTxOut(4000, changeScript))
+
+	// fee is 1000 sats, increase it by half
+	replacement, err := bumpFee(tx, []int64{10000}, changeScript, 10000)
+	c.Assert(err, IsNil)
+	c.Check(replacement.TxOut[0].Value, Equals, int64(5000))
+	c.Check(replacement.TxOut[1].Value, Equals, int64(3500))
+	c.Check(replacement.TxIn[0].Witness, IsNil)
+	c.Check(tx.TxOut[1].Value, Equals, int64(4000))
+
+	// the extra fee is at least the min relay fee
+	replacement, err = bumpFee(tx, []int64{9010}, changeScript, 10000)
+	c.Assert(err, IsNil)
+	vSize := mempool.GetTxVirtualSize(btcutil.NewTx(tx))
+	c.Check(replacement.TxOut[1].Value, Equals, 4000-vSize*minRelayFeeRate)
+
+	// the fee doesn't go over the max fee
+	replacement, err = bumpFee(tx, []int64{10000}, changeScript, 1300)
+	c.Assert(err, IsNil)
+	c.Check(replacement.TxOut[1].Value, Equals, int64(3700))
+
+	// the max fee leaves no room for the min relay fee
+	_, err = bumpFee(tx, []int64{10000}, changeScript, 1000+vSize*minRelayFeeRate-1)
+	c.Assert(err, NotNil)
+
+	// change is not enough to pay the extra fee
+	_, err = bumpFee(tx, []int64{20000}, changeScript, 100000)
+	c.Assert(err, NotNil)
+
+	// no change output
+	_, err = bumpFee(tx, []int64{10000}, []byte("another"), 10000)
+	c.Assert(err, NotNil)
+}
+
+func (s *BitcoinSignerSuite) TestReplaceSignedTx(c *C) {
+	addr, err := types2.GetRandomPubKey().GetAddress(common.BTCChain)
+	c.Assert(err, IsNil)
+	priKeyBuf, err := hex.DecodeString("b404c5ec58116b5f0fe13464a92e46626fc5db130e418cbce98df86ffe9317c5")
+	c.Assert(err, IsNil)
+	pkey, _ := btcec.PrivKeyFromBytes(btcec.S256(), priKeyBuf)
+	ksw, err := NewKeySignWrapper(pkey, s.client.bridge, s.client.ksWrapper.tssKeyManager)
+	c.Assert(err, IsNil)
+	s.client.privateKey = pkey
+	s.client.ksWrapper = ksw
+	vaultPubKey, err := GetBech32AccountPubKey(pkey)
+	c.Assert(err, IsNil)
+	txOutItem := stypes.TxOutItem{
+		Chain:       common.BTCChain,
+		ToAddress:   addr,
+		VaultPubKey: vaultPubKey,
+		Coins: common.Coins{
+			common.NewCoin(common.BTCAsset, sdk.NewUint(10)),
+		},
+		MaxGas: common.Gas{
+			common.NewCoin(common.BTCAsset, sdk.NewUint(1000)),
+		},
+		GasRate: 1,
+	}
+	txHash, err := chainhash.NewHashFromStr("256222fb25a9950479bb26049a2c00e75b89abbb7f0cf646c623b93e942c4c34")
+	c.Assert(err, IsNil)
+	blockMeta := NewBlockMeta("000000000000008a0da55afa8432af3b15c225cc7e04d32f0de912702dd9e2ae",
+		100,
+		"0000000000000068f0710c510e94bd29aa624745da43e32a1de887387306bfda")
+	blockMeta.AddUTXO(NewUnspentTransactionOutput(*txHash, 0, 0.01049996, 100, vaultPubKey))
+	c.Assert(s.client.blockMetaAccessor.SaveBlockMeta(blockMeta.Height, blockMeta), IsNil)
+	buf, err := s.client.SignTx(txOutItem, 1)
+	c.Assert(err, IsNil)
+	c.Assert(s.client.BroadcastTx(txOutItem, buf), IsNil)
+
+	signedTxs, err := s.client.blockMetaAccessor.GetSignedTxs()
+	c.Assert(err, IsNil)
+	c.Assert(signedTxs, HasLen, 1)
+	signedTx := signedTxs[0]
+	c.Check(signedTx.BroadcastHeight, Equals, int64(1722506))
+	c.Check(signedTx.InputAmounts, DeepEquals, []int64{1049996})
+	redeemTx, err := signedTx.GetMsgTx()
+	c.Assert(err, IsNil)
+	for _, in := range redeemTx.TxIn {
+		c.Check(in.Sequence, Equals, uint32(rbfSequenceNum))
+	}
+	acct, err := s.client.GetAccount(vaultPubKey)
+	c.Assert(err, IsNil)
+	change := acct.Coins[0].Amount
+
+	// tx is not stuck yet
+	stuck := s.client.processSignedTxs(&btcjson.GetBlockVerboseTxResult{Height: signedTx.BroadcastHeight + RBFBlocks - 1})
+	c.Assert(stuck, HasLen, 0)
+
+	// tx is stuck, but its change is spent already
+	changeKey := fmt.Sprintf("%s:%d", signedTx.TxID, 1)
+	c.Assert(s.client.spendUTXO(changeKey), IsNil)
+	stuck = s.client.processSignedTxs(&btcjson.GetBlockVerboseTxResult{Height: signedTx.BroadcastHeight + RBFBlocks})
+	c.Assert(stuck, HasLen, 1)
+	s.client.replaceStuckTxs(stuck, signedTx.BroadcastHeight+RBFBlocks)
+	signedTxs, err = s.client.blockMetaAccessor.GetSignedTxs()
+	c.Assert(err, IsNil)
+	c.Assert(signedTxs, HasLen, 1)
+	c.Check(signedTxs[0].TxID, Equals, signedTx.TxID)
+	c.Assert(s.client.unspendUTXO(changeKey), IsNil)
+
+	// tx is stuck, replace it with a higher fee
+	s.client.replaceStuckTxs(stuck, signedTx.BroadcastHeight+RBFBlocks)
+	signedTxs, err = s.client.blockMetaAccessor.GetSignedTxs()
+	c.Assert(err, IsNil)
+	c.Assert(signedTxs, HasLen, 1)
+	replaced := signedTxs[0]
+	c.Check(replaced.TxID, Not(Equals), signedTx.TxID)
+	c.Check(replaced.Replaced, Equals, int64(1))
+	c.Check(replaced.BroadcastHeight, Equals, signedTx.BroadcastHeight+RBFBlocks)
+	acct, err = s.client.GetAccount(vaultPubKey)
+	c.Assert(err, IsNil)
+	c.Check(acct.Coins[0].Amount < change, Equals, true)
+
+	// the original tx got confirmed, its change is spendable again
+	block := &btcjson.GetBlockVerboseTxResult{
+		Height: replaced.BroadcastHeight + 1,
+		Tx: []btcjson.TxRawResult{
+			{
+				Hex:  hex.EncodeToString(signedTx.Payload),
+				Txid: signedTx.TxID,
+				Vin: []btcjson.Vin{
+					{Txid: txHash.String(), Vout: 0},
+				},
+			},
+		},
+	}
+	s.client.processSignedTxs(block)
+	signedTxs, err = s.client.blockMetaAccessor.GetSignedTxs()
+	c.Assert(err, IsNil)
+	c.Assert(signedTxs, HasLen, 0)
+	acct, err = s.client.GetAccount(vaultPubKey)
+	c.Assert(err, IsNil)
+	c.Check(acct.Coins[0].Amount, Equals, change)
+}
+
+func (s *BitcoinSignerSuite) TestBuildConsolidateTx(c *C) {
+	vaultPubKey := types2.GetRandomPubKey()
+	addr, err := vaultPubKey.GetAddress(common.BTCChain)
+	c.Assert(err, IsNil)
+	btcAddr, err := btcutil.DecodeAddress(addr.String(), s.client.getChainCfg())
+	c.Assert(err, IsNil)
+	sourceScript, err := txscript.PayToAddrScript(btcAddr)
+	c.Assert(err, IsNil)
+
+	utxos := []UnspentTransactionOutput{GetRandomUTXO(0.1), GetRandomUTXO(0.2), GetRandomUTXO(0.3)}
+	redeemTx, inputAmounts, err := buildConsolidateTx(utxos, sourceScript, 10)
+	c.Assert(err, IsNil)
+	c.Assert(redeemTx.TxIn, HasLen, 3)
+	c.Assert(inputAmounts, HasLen, 3)
+	c.Assert(redeemTx.TxOut, HasLen, 2)
+	total := int64(0)
+	for idx, in := range redeemTx.TxIn {
+		c.Check(in.Sequence, Equals, uint32(rbfSequenceNum))
+		for _, utxo := range utxos {
+			if utxo.GetKey() == in.PreviousOutPoint.String() {
+				amt, err := btcutil.NewAmount(utxo.Value)
+				c.Assert(err, IsNil)
+				c.Check(inputAmounts[idx], Equals, int64(amt))
+			}
+		}
+		total += inputAmounts[idx]
+	}
+	c.Check(total, Equals, int64(60000000))
+	// the first output pays everything back to the vault, the memo is the second one
+	c.Check(redeemTx.TxOut[0].PkScript, DeepEquals, sourceScript)
+	vSize := mempool.GetTxVirtualSize(btcutil.NewTx(redeemTx)) + int64(len(redeemTx.TxIn))*witnessVSize
+	c.Check(redeemTx.TxOut[0].Value, Equals, total-vSize*10)
+	c.Check(redeemTx.TxOut[1].Value, Equals, int64(0))
+	nullDataScript, err := txscript.NullDataScript([]byte(ConsolidateMemo))
+	c.Assert(err, IsNil)
+	c.Check(redeemTx.TxOut[1].PkScript, DeepEquals, nullDataScript)
+
+	// not enough to pay the fee
+	_, _, err = buildConsolidateTx([]UnspentTransactionOutput{GetRandomUTXO(0.00000100)}, sourceScript, 10)
+	c.Assert(err, NotNil)
+}
+
+func (s *BitcoinSignerSuite) TestConsolidateUTXOs(c *C) {
+	// the TSS mock sign for the vault of this private key
+	priKeyBuf, err := hex.DecodeString("b404c5ec58116b5f0fe13464a92e46626fc5db130e418cbce98df86ffe9317c5")
+	c.Assert(err, IsNil)
+	pkey, _ := btcec.PrivKeyFromBytes(btcec.S256(), priKeyBuf)
+	vaultPubKey, err := GetBech32AccountPubKey(pkey)
+	c.Assert(err, IsNil)
+	s.client.ksWrapper, err = NewKeySignWrapper(s.client.privateKey, s.client.bridge, &tss.MockThorchainKeyManager{})
+	c.Assert(err, IsNil)
+
+	vault := types2.NewVault(1, types2.ActiveVault, types2.AsgardVault, vaultPubKey, common.Chains{common.BTCChain})
+	vault.Membership = common.PubKeys{s.client.nodePubKey}
+	s.asgards = types2.ModuleCdc.MustMarshalJSON(types2.Vaults{vault})
+	s.keysignParty = types2.ModuleCdc.MustMarshalJSON(common.PubKeys{s.client.nodePubKey})
+
+	height := int64(ConsolidateInterval * 100)
+	for i := int64(0); i < ConsolidateUTXOThreshold+1; i++ {
+		blockMeta := NewBlockMeta("", height-MinUTXOConfirmation-i, "")
+		utxo := GetRandomUTXO(0.01)
+		utxo.VaultPubKey = vaultPubKey
+		utxo.BlockHeight = blockMeta.Height
+		blockMeta.AddUTXO(utxo)
+		c.Assert(s.client.blockMetaAccessor.SaveBlockMeta(blockMeta.Height, blockMeta), IsNil)
+	}
+	// utxo without enough confirmations
+	blockMeta := NewBlockMeta("", height, "")
+	utxo := GetRandomUTXO(0.01)
+	utxo.VaultPubKey = vaultPubKey
+	utxo.BlockHeight = height
+	blockMeta.AddUTXO(utxo)
+	c.Assert(s.client.blockMetaAccessor.SaveBlockMeta(blockMeta.Height, blockMeta), IsNil)
+
+	utxos, err := s.client.getUTXOsToConsolidate(height, vaultPubKey)
+	c.Assert(err, IsNil)
+	c.Assert(utxos, HasLen, ConsolidateUTXOThreshold+1)
+	for i := 1; i < len(utxos); i++ {
+		c.Check(utxos[i-1].BlockHeight < utxos[i].BlockHeight, Equals, true)
+	}
+
+	// no network fee on THORChain yet
+	s.tip = height
+	s.client.consolidateUTXOs(height)
+	signedTxs, err := s.client.blockMetaAccessor.GetSignedTxs()
+	c.Assert(err, IsNil)
+	c.Assert(signedTxs, HasLen, 0)
+
+	// the local fee rate doesn't matter, only the one agreed on THORChain does
+	blockMeta.FeeRate = 1
+	c.Assert(s.client.blockMetaAccessor.SaveBlockMeta(blockMeta.Height, blockMeta), IsNil)
+	s.networkFee = types2.ModuleCdc.MustMarshalJSON(types2.NewNetworkFee(common.BTCChain, EstimateAverageTxSize, ConsolidateMaxFeeRate+1))
+	s.client.consolidateUTXOs(height)
+	signedTxs, err = s.client.blockMetaAccessor.GetSignedTxs()
+	c.Assert(err, IsNil)
+	c.Assert(signedTxs, HasLen, 0)
+
+	s.networkFee = types2.ModuleCdc.MustMarshalJSON(types2.NewNetworkFee(common.BTCChain, EstimateAverageTxSize, ConsolidateMaxFeeRate))
+	// catching up
+	s.tip = height + 1
+	s.client.consolidateUTXOs(height)
+	signedTxs, err = s.client.blockMetaAccessor.GetSignedTxs()
+	c.Assert(err, IsNil)
+	c.Assert(signedTxs, HasLen, 0)
+	s.tip = height
+
+	// not a consolidation block
+	s.client.consolidateUTXOs(height + 1)
+	signedTxs, err = s.client.blockMetaAccessor.GetSignedTxs()
+	c.Assert(err, IsNil)
+	c.Assert(signedTxs, HasLen, 0)
+
+	s.client.consolidateUTXOs(height)
+	signedTxs, err = s.client.blockMetaAccessor.GetSignedTxs()
+	c.Assert(err, IsNil)
+	c.Assert(signedTxs, HasLen, 1)
+	c.Check(signedTxs[0].TxOutItem.Memo, Equals, ConsolidateMemo)
+	redeemTx, err := signedTxs[0].GetMsgTx()
+	c.Assert(err, IsNil)
+	c.Check(redeemTx.TxIn, HasLen, ConsolidateUTXOThreshold+1)
+	// it pays the fee rate agreed on THORChain
+	fee := int64(ConsolidateUTXOThreshold+1)*1000000 - redeemTx.TxOut[0].Value
+	c.Check(fee%ConsolidateMaxFeeRate, Equals, int64(0))
+	c.Check(fee/ConsolidateMaxFeeRate > int64(len(redeemTx.TxIn))*witnessVSize, Equals, true)
+
+	// all the confirmed utxos are spent, only the unconfirmed one and the consolidated one are left
+	utxos, err = s.client.getUTXOsToConsolidate(height, vaultPubKey)
+	c.Assert(err, IsNil)
+	c.Assert(utxos, HasLen, 0)
+	acct, err := s.client.GetAccount(vaultPubKey)
+	c.Assert(err, IsNil)
+	c.Check(int64(acct.Coins[0].Amount), Equals, int64(1000000)+redeemTx.TxOut[0].Value)
+
+	// not enough utxos to consolidate any more
+	s.tip = height + ConsolidateInterval
+	s.client.consolidateUTXOs(height + ConsolidateInterval)
+	signedTxs, err = s.client.blockMetaAccessor.GetSignedTxs()
+	c.Assert(err, IsNil)
+	c.Assert(signedTxs, HasLen, 1)
+}
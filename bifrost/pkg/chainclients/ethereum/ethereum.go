@@ -41,7 +41,7 @@ type Client struct {
 
 // NewClient create new instance of Ethereum client
 func NewClient(thorKeys *thorclient.Keys, cfg config.ChainConfiguration, server *tssp.TssServer, thorchainBridge *thorclient.ThorchainBridge, m *metrics.Metrics) (*Client, error) {
-	tssKm, err := tss.NewKeySign(server)
+	tssKm, err := tss.NewKeySign(server, m)
 	if err != nil {
 		return nil, fmt.Errorf("fail to create tss signer: %w", err)
 	}
@@ -242,7 +242,7 @@ func (c *Client) sign(tx *etypes.Transaction, from string, poolPubKey common.Pub
 			return nil, err
 		} else {
 			c.logger.Info().Str("tx_id", txID.String()).Msgf("post keysign failure to thorchain")
-			return nil, fmt.Errorf("sent keysign failure to thorchain")
+			return nil, fmt.Errorf("sent keysign failure to thorchain: %w", keysignError)
 		}
 	}
 	c.logger.Error().Err(err).Msg("fail to sign tx")
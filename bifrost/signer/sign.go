@@ -95,7 +95,7 @@ func NewSigner(cfg config.SignerConfiguration,
 		return nil, fmt.Errorf("fail to create block scanner: %w", err)
 	}
 
-	kg, err := tss.NewTssKeyGen(thorKeys, tssServer)
+	kg, err := tss.NewTssKeyGen(thorKeys, tssServer, m)
 	if err != nil {
 		return nil, fmt.Errorf("fail to create Tss Key gen,err:%w", err)
 	}
@@ -179,6 +179,11 @@ func (s *Signer) processTransactions() {
 					s.logger.Info().Msgf("Signing transaction (Num: %d | Height: %d | Status: %d): %+v", i, item.Height, item.Status, item.TxOutItem)
 					if err := s.signAndBroadcast(item); err != nil {
 						s.logger.Error().Err(err).Msg("fail to sign and broadcast tx out store item")
+						// the item stay in storage, and will be retried in the next round
+						var keysignError tss.KeysignError
+						if errors.As(err, &keysignError) {
+							s.m.GetCounterVec(metrics.SignerKeysignRetry).WithLabelValues(item.TxOutItem.Chain.String()).Inc()
+						}
 						return
 					}
 
@@ -195,6 +200,7 @@ func (s *Signer) processTransactions() {
 }
 
 // processTxnOut processes inbound TxOuts and save them to storage
+// TODO sign all the items of the same vault in one keysign round once the go-tss keysign request takes several messages
 func (s *Signer) processTxnOut(ch <-chan types.TxOut, idx int) {
 	s.logger.Info().Int("idx", idx).Msg("start to process tx out")
 	defer s.logger.Info().Int("idx", idx).Msg("stop to process tx out")
@@ -2,6 +2,7 @@ package signer
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -16,8 +17,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/rs/zerolog/log"
 	"github.com/tendermint/tendermint/crypto"
+	"gitlab.com/thorchain/tss/go-tss/blame"
 
 	. "gopkg.in/check.v1"
 
@@ -28,6 +31,7 @@ import (
 	pubkeymanager "gitlab.com/thorchain/thornode/bifrost/pubkeymanager"
 	"gitlab.com/thorchain/thornode/bifrost/thorclient"
 	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
+	"gitlab.com/thorchain/thornode/bifrost/tss"
 	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/x/thorchain"
 	types2 "gitlab.com/thorchain/thornode/x/thorchain/types"
@@ -176,10 +180,11 @@ func (s *SignSuite) TearDownSuite(c *C) {
 
 type MockChainClient struct {
 	account common.Account
+	signErr error
 }
 
 func (b *MockChainClient) SignTx(tai stypes.TxOutItem, height int64) ([]byte, error) {
-	return nil, nil
+	return nil, b.signErr
 }
 
 func (b *MockChainClient) GetConfig() config.ChainConfiguration {
@@ -294,6 +299,56 @@ func (s *SignSuite) TestHandleYggReturn_Success_NotEnough(c *C) {
 	c.Check(newItem.Coins, HasLen, 0)
 }
 
+func (s *SignSuite) TestKeysignRetry(c *C) {
+	storage, err := NewSignerStore("", "")
+	c.Assert(err, IsNil)
+	item := NewTxOutStoreItem(1, stypes.TxOutItem{
+		Chain:       common.BTCChain,
+		ToAddress:   "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh",
+		VaultPubKey: types2.GetRandomPubKey(),
+		Coins:       common.Coins{common.NewCoin(common.BTCAsset, sdk.NewUint(common.One))},
+	})
+	c.Assert(storage.Set(item), IsNil)
+	sign := &Signer{
+		logger:          log.With().Str("module", "signer").Logger(),
+		stopChan:        make(chan struct{}),
+		chains:          map[common.Chain]chainclients.ChainClient{},
+		m:               s.m,
+		storage:         storage,
+		errCounter:      s.m.GetCounterVec(metrics.SignerError),
+		pubkeyMgr:       pubkeymanager.NewMockPoolAddressValidator(),
+		thorchainBridge: s.bridge,
+	}
+
+	// chain client is not available, signing fail and the item will be retried,
+	// but it isn't a keysign failure
+	counter := s.m.GetCounterVec(metrics.SignerKeysignRetry).WithLabelValues(common.BTCChain.String())
+	before := testutil.ToFloat64(counter)
+	sign.processTransactions()
+	c.Check(testutil.ToFloat64(counter)-before, Equals, float64(0))
+	items := storage.List()
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Status, Equals, TxAvailable)
+
+	// keysign fail, the item will be retried
+	sign.pubkeyMgr = &MockSigningPoolAddressValidator{pubkeymanager.NewMockPoolAddressValidator()}
+	sign.chains[common.BTCChain] = &MockChainClient{
+		signErr: fmt.Errorf("sent keysign failure to thorchain: %w", tss.NewKeysignError(blame.Blame{FailReason: "timeout"})),
+	}
+	sign.processTransactions()
+	c.Check(testutil.ToFloat64(counter)-before, Equals, float64(1))
+	items = storage.List()
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Status, Equals, TxAvailable)
+}
+
+// MockSigningPoolAddressValidator is a member of every vault
+type MockSigningPoolAddressValidator struct {
+	*pubkeymanager.MockPoolAddressValidator
+}
+
+func (m *MockSigningPoolAddressValidator) HasPubKey(_ common.PubKey) bool { return true }
+
 func (s *SignSuite) TestProcess(c *C) {
 	cfg := config.SignerConfiguration{
 		SignerDbPath: filepath.Join(os.TempDir(), "/var/data/bifrost/signer"),
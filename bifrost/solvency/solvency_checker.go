@@ -0,0 +1,267 @@
+package solvency
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"gitlab.com/thorchain/thornode/bifrost/config"
+	"gitlab.com/thorchain/thornode/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients"
+	"gitlab.com/thorchain/thornode/common"
+	stypes "gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+// Discrepancy is an asset of a vault which balance on chain doesn't match the balance THORChain think it has
+type Discrepancy struct {
+	Chain           common.Chain     `json:"chain"`
+	VaultPubKey     common.PubKey    `json:"vault_pub_key"`
+	VaultType       stypes.VaultType `json:"vault_type"`
+	Asset           common.Asset     `json:"asset"`
+	ThorchainAmount sdk.Uint         `json:"thorchain_amount"`
+	PendingAmount   sdk.Uint         `json:"pending_amount"`
+	ChainAmount     sdk.Uint         `json:"chain_amount"`
+}
+
+// IsInsolvent return true when the vault has less funds on chain than THORChain think it has, once the
+// outbound txs it may have sent already are taken out, by more than the given tolerance in basis points
+func (d Discrepancy) IsInsolvent(tolerance int64) bool {
+	expected := common.SafeSub(d.ThorchainAmount, d.PendingAmount)
+	if tolerance > 0 {
+		expected = common.SafeSub(expected, common.GetShare(sdk.NewUint(uint64(tolerance)), sdk.NewUint(10000), expected))
+	}
+	return d.ChainAmount.LT(expected)
+}
+
+// Report is the result of a solvency check
+type Report struct {
+	Time                time.Time     `json:"time"`
+	Solvent             bool          `json:"solvent"`
+	ConsecutiveFailures int           `json:"consecutive_failures"`
+	Discrepancies       []Discrepancy `json:"discrepancies"`
+}
+
+// ThorchainBridge is the part of thorclient.ThorchainBridge the checker need to get vaults from THORChain
+type ThorchainBridge interface {
+	GetAsgards() (stypes.Vaults, error)
+	GetYggdrasils() (stypes.Vaults, error)
+	GetPendingOutbounds() ([]stypes.TxOutItem, error)
+}
+
+// SolvencyChecker periodically compares the balance of every asgard and yggdrasil
+// vault on chain with the vault coins on THORChain
+type SolvencyChecker struct {
+	logger           zerolog.Logger
+	cfg              config.SolvencyConfiguration
+	bridge           ThorchainBridge
+	chains           map[common.Chain]chainclients.ChainClient
+	errCounter       *prometheus.CounterVec
+	discrepancyGauge *prometheus.GaugeVec
+	rwMutex          *sync.RWMutex
+	report           Report
+	failures         int
+	stopChan         chan struct{}
+	wg               *sync.WaitGroup
+}
+
+// NewSolvencyChecker create a new instance of SolvencyChecker
+func NewSolvencyChecker(cfg config.SolvencyConfiguration, bridge ThorchainBridge, chains map[common.Chain]chainclients.ChainClient, m *metrics.Metrics) (*SolvencyChecker, error) {
+	if bridge == nil {
+		return nil, errors.New("thorchain bridge is nil")
+	}
+	if m == nil {
+		return nil, errors.New("metrics is nil")
+	}
+	return &SolvencyChecker{
+		logger:           log.With().Str("module", "solvency_checker").Logger(),
+		cfg:              cfg,
+		bridge:           bridge,
+		chains:           chains,
+		errCounter:       m.GetCounterVec(metrics.SolvencyCheckerError),
+		discrepancyGauge: m.GetGaugeVec(metrics.VaultBalanceDiscrepancy),
+		rwMutex:          &sync.RWMutex{},
+		report:           Report{Solvent: true},
+		stopChan:         make(chan struct{}),
+		wg:               &sync.WaitGroup{},
+	}, nil
+}
+
+// Start to check vault solvency periodically
+func (c *SolvencyChecker) Start() error {
+	if !c.cfg.Enabled {
+		c.logger.Info().Msg("solvency checker is disabled")
+		return nil
+	}
+	if c.cfg.CheckInterval <= 0 {
+		return fmt.Errorf("invalid check interval: %s", c.cfg.CheckInterval)
+	}
+	c.wg.Add(1)
+	go c.checkVaults()
+	return nil
+}
+
+// Stop the solvency checker
+func (c *SolvencyChecker) Stop() error {
+	defer c.logger.Info().Msg("solvency checker stopped")
+	close(c.stopChan)
+	c.wg.Wait()
+	return nil
+}
+
+// GetReport return the report of the latest solvency check
+func (c *SolvencyChecker) GetReport() Report {
+	c.rwMutex.RLock()
+	defer c.rwMutex.RUnlock()
+	return c.report
+}
+
+func (c *SolvencyChecker) checkVaults() {
+	c.logger.Info().Msg("start to check vault solvency")
+	defer c.logger.Info().Msg("stop to check vault solvency")
+	defer c.wg.Done()
+	for {
+		select {
+		case <-c.stopChan:
+			return
+		case <-time.After(c.cfg.CheckInterval):
+			if _, err := c.Check(); err != nil {
+				c.logger.Error().Err(err).Msg("fail to check vault solvency")
+			}
+		}
+	}
+}
+
+// Check compare the balance of all the vaults on chain with THORChain, and
+// save the result as the latest report
+func (c *SolvencyChecker) Check() (Report, error) {
+	asgards, err := c.bridge.GetAsgards()
+	if err != nil {
+		c.errCounter.WithLabelValues("fail_get_asgards", "").Inc()
+		return Report{}, fmt.Errorf("fail to get asgard vaults: %w", err)
+	}
+	yggs, err := c.bridge.GetYggdrasils()
+	if err != nil {
+		c.errCounter.WithLabelValues("fail_get_yggdrasils", "").Inc()
+		return Report{}, fmt.Errorf("fail to get yggdrasil vaults: %w", err)
+	}
+	// the signers may have sent the pending outbound txs already, THORChain only
+	// take them out of the vault once they are observed
+	outbounds, err := c.bridge.GetPendingOutbounds()
+	if err != nil {
+		c.errCounter.WithLabelValues("fail_get_pending_outbounds", "").Inc()
+		return Report{}, fmt.Errorf("fail to get pending outbounds: %w", err)
+	}
+	pending := make(map[string]sdk.Uint)
+	for _, item := range outbounds {
+		coins := append(common.Coins{item.Coin}, item.MaxGas.ToCoins()...)
+		for _, coin := range coins {
+			key := pendingKey(item.VaultPubKey, coin.Asset)
+			if amt, ok := pending[key]; ok {
+				pending[key] = amt.Add(coin.Amount)
+			} else {
+				pending[key] = coin.Amount
+			}
+		}
+	}
+
+	insolvent := false
+	var discrepancies []Discrepancy
+	for _, vault := range append(asgards, yggs...) {
+		for _, d := range c.checkVault(vault, pending) {
+			discrepancies = append(discrepancies, d)
+			if d.IsInsolvent(c.cfg.Tolerance) {
+				insolvent = true
+			}
+		}
+	}
+
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+	if insolvent {
+		c.failures++
+	} else {
+		c.failures = 0
+	}
+	// the vaults on THORChain and the balances on chain are not read at the
+	// same height, a vault can look short for a check, only report it when it lasts
+	threshold := c.cfg.FailureThreshold
+	if threshold < 1 {
+		threshold = 1
+	}
+	report := Report{
+		Time:                time.Now(),
+		Solvent:             c.failures < threshold,
+		ConsecutiveFailures: c.failures,
+		Discrepancies:       discrepancies,
+	}
+	if !report.Solvent {
+		c.logger.Error().Interface("discrepancies", report.Discrepancies).Msg("vaults are insolvent")
+	} else if insolvent {
+		c.logger.Warn().Int("failures", c.failures).Interface("discrepancies", report.Discrepancies).Msg("vaults are short of funds")
+	}
+	c.report = report
+	return report, nil
+}
+
+func pendingKey(pubKey common.PubKey, asset common.Asset) string {
+	return fmt.Sprintf("%s-%s", pubKey, asset)
+}
+
+// checkVault compare the coins of the given vault with its balance on each chain
+func (c *SolvencyChecker) checkVault(vault stypes.Vault, pending map[string]sdk.Uint) []Discrepancy {
+	var discrepancies []Discrepancy
+	for chain, client := range c.chains {
+		if !vault.Chains.Has(chain) && !vault.HasFundsForChain(chain) {
+			continue
+		}
+		acct, err := client.GetAccount(vault.PubKey)
+		if err != nil {
+			c.errCounter.WithLabelValues("fail_get_account", chain.String()).Inc()
+			c.logger.Error().Err(err).Str("chain", chain.String()).Str("vault", vault.PubKey.String()).Msg("fail to get account")
+			continue
+		}
+		for _, coin := range vault.Coins {
+			if !coin.Asset.Chain.Equals(chain) {
+				continue
+			}
+			chainAmount := getAccountBalance(acct, coin.Asset)
+			diff := float64(coin.Amount.Uint64()) - float64(chainAmount.Uint64())
+			c.discrepancyGauge.WithLabelValues(chain.String(), vault.PubKey.String(), coin.Asset.String()).Set(diff)
+			if chainAmount.Equal(coin.Amount) {
+				continue
+			}
+			pendingAmount, ok := pending[pendingKey(vault.PubKey, coin.Asset)]
+			if !ok {
+				pendingAmount = sdk.ZeroUint()
+			}
+			discrepancies = append(discrepancies, Discrepancy{
+				Chain:           chain,
+				VaultPubKey:     vault.PubKey,
+				VaultType:       vault.Type,
+				Asset:           coin.Asset,
+				ThorchainAmount: coin.Amount,
+				PendingAmount:   pendingAmount,
+				ChainAmount:     chainAmount,
+			})
+		}
+	}
+	return discrepancies
+}
+
+// getAccountBalance return the balance of the given asset in the account, chain
+// clients use either the asset symbol (ie BNB) or the full asset name (ie BTC.BTC) as denom
+func getAccountBalance(acct common.Account, asset common.Asset) sdk.Uint {
+	for _, coin := range acct.Coins {
+		if strings.EqualFold(coin.Denom, asset.Symbol.String()) || strings.EqualFold(coin.Denom, asset.String()) {
+			return sdk.NewUint(coin.Amount)
+		}
+	}
+	return sdk.ZeroUint()
+}
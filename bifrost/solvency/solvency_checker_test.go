@@ -0,0 +1,195 @@
+package solvency
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/bifrost/config"
+	"gitlab.com/thorchain/thornode/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients"
+	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
+	"gitlab.com/thorchain/thornode/common"
+	ttypes "gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type SolvencyCheckerSuite struct {
+	m *metrics.Metrics
+}
+
+var _ = Suite(&SolvencyCheckerSuite{})
+
+func (s *SolvencyCheckerSuite) SetUpSuite(c *C) {
+	ttypes.SetupConfigForTest()
+	var err error
+	s.m, err = metrics.NewMetrics(config.MetricsConfiguration{
+		Enabled:      false,
+		ListenPort:   9000,
+		ReadTimeout:  time.Second,
+		WriteTimeout: time.Second,
+		Chains:       common.Chains{common.BNBChain},
+	})
+	c.Assert(err, IsNil)
+}
+
+type mockBridge struct {
+	asgards   ttypes.Vaults
+	yggs      ttypes.Vaults
+	outbounds []ttypes.TxOutItem
+}
+
+func (b mockBridge) GetAsgards() (ttypes.Vaults, error)               { return b.asgards, nil }
+func (b mockBridge) GetYggdrasils() (ttypes.Vaults, error)            { return b.yggs, nil }
+func (b mockBridge) GetPendingOutbounds() ([]ttypes.TxOutItem, error) { return b.outbounds, nil }
+
+type mockChain struct {
+	chain    common.Chain
+	accounts map[common.PubKey]common.Account
+}
+
+func (m mockChain) SignTx(tx stypes.TxOutItem, height int64) ([]byte, error) { return nil, nil }
+func (m mockChain) BroadcastTx(_ stypes.TxOutItem, _ []byte) error           { return nil }
+func (m mockChain) GetHeight() (int64, error)                                { return 0, nil }
+func (m mockChain) GetAddress(poolPubKey common.PubKey) string               { return "" }
+func (m mockChain) GetAccount(poolPubKey common.PubKey) (common.Account, error) {
+	return m.accounts[poolPubKey], nil
+}
+func (m mockChain) GetChain() common.Chain                              { return m.chain }
+func (m mockChain) Start(_ chan stypes.TxIn, _ chan stypes.ErrataBlock) {}
+func (m mockChain) GetConfig() config.ChainConfiguration                { return config.ChainConfiguration{} }
+func (m mockChain) Stop()                                               {}
+
+func (s *SolvencyCheckerSuite) TestCheck(c *C) {
+	asgard := ttypes.GetRandomVault()
+	asgard.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(100*common.One)),
+		common.NewCoin(common.BTCAsset, sdk.NewUint(2*common.One)),
+	}
+	ygg := ttypes.GetRandomVault()
+	ygg.Type = ttypes.YggdrasilVault
+	ygg.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(10*common.One)),
+	}
+	bnb := mockChain{
+		chain: common.BNBChain,
+		accounts: map[common.PubKey]common.Account{
+			asgard.PubKey: common.NewAccount(0, 0, common.AccountCoins{
+				{Amount: 100 * common.One, Denom: "BNB"},
+			}),
+			ygg.PubKey: common.NewAccount(0, 0, common.AccountCoins{
+				{Amount: 11 * common.One, Denom: "BNB"},
+			}),
+		},
+	}
+	btc := mockChain{
+		chain: common.BTCChain,
+		accounts: map[common.PubKey]common.Account{
+			asgard.PubKey: common.NewAccount(0, 0, common.AccountCoins{
+				{Amount: common.One, Denom: common.BTCAsset.String()},
+			}),
+		},
+	}
+	chains := map[common.Chain]chainclients.ChainClient{
+		common.BNBChain: bnb,
+		common.BTCChain: btc,
+	}
+	checker, err := NewSolvencyChecker(config.SolvencyConfiguration{}, mockBridge{
+		asgards: ttypes.Vaults{asgard},
+		yggs:    ttypes.Vaults{ygg},
+	}, chains, s.m)
+	c.Assert(err, IsNil)
+	c.Check(checker.GetReport().Solvent, Equals, true)
+
+	report, err := checker.Check()
+	c.Assert(err, IsNil)
+	c.Check(report.Solvent, Equals, false)
+	c.Assert(report.Discrepancies, HasLen, 2)
+	for _, d := range report.Discrepancies {
+		if d.VaultPubKey.Equals(asgard.PubKey) {
+			// asgard is short of 1 BTC
+			c.Check(d.Asset.Equals(common.BTCAsset), Equals, true)
+			c.Check(d.ChainAmount.Uint64(), Equals, uint64(common.One))
+			c.Check(d.IsInsolvent(0), Equals, true)
+		} else {
+			// yggdrasil has more BNB than expected, still solvent
+			c.Check(d.VaultPubKey.Equals(ygg.PubKey), Equals, true)
+			c.Check(d.IsInsolvent(0), Equals, false)
+		}
+	}
+	c.Check(checker.GetReport().Solvent, Equals, false)
+	c.Assert(checker.Stop(), IsNil)
+}
+
+func (s *SolvencyCheckerSuite) TestCheckPendingOutboundsAndTolerance(c *C) {
+	asgard := ttypes.GetRandomVault()
+	asgard.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(100*common.One)),
+	}
+	accounts := map[common.PubKey]common.Account{
+		// 1 BNB has been sent out already, but not observed yet
+		asgard.PubKey: common.NewAccount(0, 0, common.AccountCoins{
+			{Amount: 99 * common.One, Denom: "BNB"},
+		}),
+	}
+	chains := map[common.Chain]chainclients.ChainClient{
+		common.BNBChain: mockChain{chain: common.BNBChain, accounts: accounts},
+	}
+	bridge := mockBridge{
+		asgards: ttypes.Vaults{asgard},
+		outbounds: []ttypes.TxOutItem{
+			{
+				Chain:       common.BNBChain,
+				VaultPubKey: asgard.PubKey,
+				Coin:        common.NewCoin(common.BNBAsset, sdk.NewUint(common.One-37500)),
+				MaxGas:      common.Gas{common.NewCoin(common.BNBAsset, sdk.NewUint(37500))},
+			},
+		},
+	}
+	cfg := config.SolvencyConfiguration{
+		Tolerance:        10,
+		FailureThreshold: 2,
+	}
+	checker, err := NewSolvencyChecker(cfg, bridge, chains, s.m)
+	c.Assert(err, IsNil)
+	report, err := checker.Check()
+	c.Assert(err, IsNil)
+	c.Check(report.Solvent, Equals, true)
+	c.Assert(report.Discrepancies, HasLen, 1)
+	c.Check(report.Discrepancies[0].PendingAmount.Uint64(), Equals, uint64(common.One))
+	c.Check(report.Discrepancies[0].IsInsolvent(cfg.Tolerance), Equals, false)
+
+	// short of less than the tolerance
+	accounts[asgard.PubKey] = common.NewAccount(0, 0, common.AccountCoins{
+		{Amount: 99*common.One - 5000000, Denom: "BNB"},
+	})
+	report, err = checker.Check()
+	c.Assert(err, IsNil)
+	c.Check(report.Solvent, Equals, true)
+	c.Check(report.ConsecutiveFailures, Equals, 0)
+
+	// only reported insolvent after 2 failing checks in a row
+	accounts[asgard.PubKey] = common.NewAccount(0, 0, common.AccountCoins{
+		{Amount: 98 * common.One, Denom: "BNB"},
+	})
+	report, err = checker.Check()
+	c.Assert(err, IsNil)
+	c.Check(report.Solvent, Equals, true)
+	c.Check(report.ConsecutiveFailures, Equals, 1)
+	report, err = checker.Check()
+	c.Assert(err, IsNil)
+	c.Check(report.Solvent, Equals, false)
+	c.Check(report.ConsecutiveFailures, Equals, 2)
+	c.Check(checker.GetReport().Solvent, Equals, false)
+
+	accounts[asgard.PubKey] = common.NewAccount(0, 0, common.AccountCoins{
+		{Amount: 99 * common.One, Denom: "BNB"},
+	})
+	report, err = checker.Check()
+	c.Assert(err, IsNil)
+	c.Check(report.Solvent, Equals, true)
+	c.Check(report.ConsecutiveFailures, Equals, 0)
+}
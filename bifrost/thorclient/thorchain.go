@@ -40,6 +40,9 @@ const (
 	SignerMembershipEndpoint = "/thorchain/vaults/%s/signers"
 	StatusEndpoint           = "/status"
 	AsgardVault              = "/thorchain/vaults/asgard"
+	YggdrasilVault           = "/thorchain/vaults/yggdrasil"
+	OutboundQueueEndpoint    = "/thorchain/queue/outbound"
+	NetworkFeeEndpoint       = "/thorchain/network_fee/%s"
 )
 
 // ThorchainBridge will be used to send tx to thorchain
@@ -222,6 +225,22 @@ func (b *ThorchainBridge) PostKeysignFailure(blame blame.Blame, height int64, me
 	return b.Broadcast(stdTx, types.TxSync)
 }
 
+// PostNetworkFee send the fee rate of the given chain bifrost observed at the given block height to thorchain
+func (b *ThorchainBridge) PostNetworkFee(height int64, chain common.Chain, transactionSize, transactionRate uint64) (common.TxID, error) {
+	start := time.Now()
+	defer func() {
+		b.m.GetHistograms(metrics.SignToThorchainDuration).Observe(time.Since(start).Seconds())
+	}()
+	msg := stypes.NewMsgNetworkFee(height, chain, transactionSize, transactionRate, b.keys.GetSignerInfo().GetAddress())
+	stdTx := authtypes.NewStdTx(
+		[]sdk.Msg{msg},
+		authtypes.NewStdFee(100000000, nil), // fee
+		nil,                                 // signatures
+		"",                                  // memo
+	)
+	return b.Broadcast(stdTx, types.TxSync)
+}
+
 // GetErrataStdTx get errata tx from params
 func (b *ThorchainBridge) GetErrataStdTx(txID common.TxID, chain common.Chain) (*authtypes.StdTx, error) {
 	start := time.Now()
@@ -270,7 +289,11 @@ func (b *ThorchainBridge) GetObservationsStdTx(txIns stypes.ObservedTxs) (*autht
 		if err != nil {
 			return nil, err
 		}
-		if tx.Tx.ToAddress.Equals(obAddr) {
+		if tx.Tx.ToAddress.Equals(obAddr) && tx.Tx.FromAddress.Equals(obAddr) {
+			// a vault sending to itself (utxo consolidation) is both an inbound and an outbound tx
+			inbound = append(inbound, tx)
+			outbound = append(outbound, tx)
+		} else if tx.Tx.ToAddress.Equals(obAddr) {
 			inbound = append(inbound, tx)
 		} else if tx.Tx.FromAddress.Equals(obAddr) {
 			outbound = append(outbound, tx)
@@ -404,3 +427,55 @@ func (b *ThorchainBridge) GetAsgards() (stypes.Vaults, error) {
 	}
 	return vaults, nil
 }
+
+// GetYggdrasils retrieve all the yggdrasil vaults that still hold funds from thorchain
+func (b *ThorchainBridge) GetYggdrasils() (stypes.Vaults, error) {
+	buf, s, err := b.getWithPath(YggdrasilVault)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get yggdrasil vaults: %w", err)
+	}
+	if s != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", s)
+	}
+	var resp []stypes.QueryYggdrasilVaults
+	if err := b.cdc.UnmarshalJSON(buf, &resp); err != nil {
+		return nil, fmt.Errorf("fail to unmarshal yggdrasil vaults from json: %w", err)
+	}
+	vaults := make(stypes.Vaults, len(resp))
+	for i, item := range resp {
+		vaults[i] = item.Vault
+	}
+	return vaults, nil
+}
+
+// GetPendingOutbounds retrieve the outbound txs that haven't been sent yet from thorchain
+func (b *ThorchainBridge) GetPendingOutbounds() ([]stypes.TxOutItem, error) {
+	buf, s, err := b.getWithPath(OutboundQueueEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get outbound queue: %w", err)
+	}
+	if s != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", s)
+	}
+	var items []stypes.TxOutItem
+	if err := b.cdc.UnmarshalJSON(buf, &items); err != nil {
+		return nil, fmt.Errorf("fail to unmarshal outbound queue from json: %w", err)
+	}
+	return items, nil
+}
+
+// GetNetworkFee retrieve the network fee of the given chain agreed by the active nodes from thorchain
+func (b *ThorchainBridge) GetNetworkFee(chain common.Chain) (stypes.NetworkFee, error) {
+	buf, s, err := b.getWithPath(fmt.Sprintf(NetworkFeeEndpoint, chain))
+	if err != nil {
+		return stypes.NetworkFee{}, fmt.Errorf("fail to get network fee: %w", err)
+	}
+	if s != http.StatusOK {
+		return stypes.NetworkFee{}, fmt.Errorf("unexpected status code %d", s)
+	}
+	var networkFee stypes.NetworkFee
+	if err := b.cdc.UnmarshalJSON(buf, &networkFee); err != nil {
+		return stypes.NetworkFee{}, fmt.Errorf("fail to unmarshal network fee from json: %w", err)
+	}
+	return networkFee, nil
+}
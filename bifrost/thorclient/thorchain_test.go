@@ -49,6 +49,12 @@ func (s *ThorchainSuite) SetUpSuite(c *C) {
 			httpTestHandler(c, rw, "../../test/fixtures/endpoints/tss/keysign_party.json")
 		case strings.HasPrefix(req.RequestURI, AsgardVault):
 			httpTestHandler(c, rw, "../../test/fixtures/endpoints/vaults/asgard.json")
+		case strings.HasPrefix(req.RequestURI, YggdrasilVault):
+			httpTestHandler(c, rw, "../../test/fixtures/endpoints/vaults/yggdrasil.json")
+		case strings.HasPrefix(req.RequestURI, "/thorchain/network_fee/"):
+			httpTestHandler(c, rw, "../../test/fixtures/endpoints/network_fee/btc.json")
+		case strings.HasPrefix(req.RequestURI, OutboundQueueEndpoint):
+			httpTestHandler(c, rw, "../../test/fixtures/endpoints/queue/outbound.json")
 		}
 	}))
 	s.cfg.ChainHost = s.server.Listener.Addr().String()
@@ -119,6 +125,15 @@ func (s *ThorchainSuite) TestSign(c *C) {
 	c.Log(err)
 	c.Assert(signedMsg, NotNil)
 	c.Assert(err, IsNil)
+	c.Assert(signedMsg.Msgs, HasLen, 1)
+
+	// a vault sending to itself is observed as both inbound and outbound
+	tx.Tx.ToAddress = vaultAddr
+	signedMsg, err = s.bridge.GetObservationsStdTx(stypes.ObservedTxs{tx})
+	c.Assert(err, IsNil)
+	c.Assert(signedMsg.Msgs, HasLen, 2)
+	c.Check(signedMsg.Msgs[0].Type(), Equals, stypes.MsgObservedTxIn{}.Type())
+	c.Check(signedMsg.Msgs[1].Type(), Equals, stypes.MsgObservedTxOut{}.Type())
 }
 
 func (ThorchainSuite) TestNewThorchainBridge(c *C) {
@@ -246,3 +261,28 @@ func (s *ThorchainSuite) TestGetAsgards(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(vaults, NotNil)
 }
+
+func (s *ThorchainSuite) TestGetYggdrasils(c *C) {
+	vaults, err := s.bridge.GetYggdrasils()
+	c.Assert(err, IsNil)
+	c.Assert(vaults, HasLen, 1)
+	c.Check(vaults[0].IsYggdrasil(), Equals, true)
+	c.Check(vaults[0].GetCoin(common.BNBAsset).Amount.Uint64(), Equals, uint64(100000000))
+}
+
+func (s *ThorchainSuite) TestGetPendingOutbounds(c *C) {
+	items, err := s.bridge.GetPendingOutbounds()
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Coin.Equals(common.NewCoin(common.BNBAsset, sdk.NewUint(100000000))), Equals, true)
+	c.Check(items[0].MaxGas.ToCoins().GetCoin(common.BNBAsset).Amount.Uint64(), Equals, uint64(37500))
+	c.Check(items[0].OutHash.IsEmpty(), Equals, true)
+}
+
+func (s *ThorchainSuite) TestGetNetworkFee(c *C) {
+	networkFee, err := s.bridge.GetNetworkFee(common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(networkFee.Chain.Equals(common.BTCChain), Equals, true)
+	c.Check(networkFee.TransactionSize, Equals, uint64(250))
+	c.Check(networkFee.TransactionFeeRate, Equals, uint64(25))
+}
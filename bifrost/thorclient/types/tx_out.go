@@ -16,6 +16,7 @@ type TxOutItem struct {
 	Coins       common.Coins   `json:"coins"`
 	Memo        string         `json:"memo"`
 	MaxGas      common.Gas     `json:"max_gas"`
+	GasRate     int64          `json:"gas_rate"`
 	InHash      common.TxID    `json:"in_hash"`
 	OutHash     common.TxID    `json:"out_hash"`
 }
@@ -54,6 +55,7 @@ type TxArrayItem struct {
 	Coin        common.Coin    `json:"coin"`
 	Memo        string         `json:"memo"`
 	MaxGas      common.Gas     `json:"max_gas"`
+	GasRate     int64          `json:"gas_rate"`
 	InHash      common.TxID    `json:"in_hash"`
 	OutHash     common.TxID    `json:"out_hash"`
 }
@@ -66,6 +68,7 @@ func (tx TxArrayItem) TxOutItem() TxOutItem {
 		Coins:       common.Coins{tx.Coin},
 		Memo:        tx.Memo,
 		MaxGas:      tx.MaxGas,
+		GasRate:     tx.GasRate,
 		InHash:      tx.InHash,
 		OutHash:     tx.OutHash,
 	}
@@ -11,6 +11,7 @@ import (
 	"gitlab.com/thorchain/tss/go-tss/keygen"
 	tss "gitlab.com/thorchain/tss/go-tss/tss"
 
+	"gitlab.com/thorchain/thornode/bifrost/metrics"
 	"gitlab.com/thorchain/thornode/bifrost/thorclient"
 	"gitlab.com/thorchain/thornode/common"
 )
@@ -21,13 +22,17 @@ type KeyGen struct {
 	logger zerolog.Logger
 	client *http.Client
 	server *tss.TssServer
+	m      *metrics.Metrics
 }
 
 // NewTssKeyGen create a new instance of TssKeyGen which will look after TSS key stuff
-func NewTssKeyGen(keys *thorclient.Keys, server *tss.TssServer) (*KeyGen, error) {
+func NewTssKeyGen(keys *thorclient.Keys, server *tss.TssServer, m *metrics.Metrics) (*KeyGen, error) {
 	if keys == nil {
 		return nil, fmt.Errorf("keys is nil")
 	}
+	if m == nil {
+		return nil, fmt.Errorf("metrics is nil")
+	}
 	return &KeyGen{
 		keys:   keys,
 		logger: log.With().Str("module", "tss_keygen").Logger(),
@@ -35,14 +40,27 @@ func NewTssKeyGen(keys *thorclient.Keys, server *tss.TssServer) (*KeyGen, error)
 			Timeout: time.Second * 130,
 		},
 		server: server,
+		m:      m,
 	}, nil
 }
 
-func (kg *KeyGen) GenerateNewKey(pKeys common.PubKeys) (common.PubKeySet, blame.Blame, error) {
+func (kg *KeyGen) GenerateNewKey(pKeys common.PubKeys) (pubKeySet common.PubKeySet, b blame.Blame, err error) {
 	// No need to do key gen
 	if len(pKeys) == 0 {
 		return common.EmptyPubKeySet, blame.Blame{}, nil
 	}
+	start := time.Now()
+	defer func() {
+		kg.m.GetHistograms(metrics.TSSKeygenDuration).Observe(time.Since(start).Seconds())
+		result := resultSuccess
+		if err != nil || !b.IsEmpty() {
+			result = resultFailure
+		}
+		kg.m.GetCounterVec(metrics.TSSKeygen).WithLabelValues(result).Inc()
+		recordBlame(kg.m, ceremonyKeygen, b)
+	}()
+	kg.m.GetHistograms(metrics.TSSKeygenPartySize).Observe(float64(len(pKeys)))
+
 	var keys []string
 	for _, item := range pKeys {
 		keys = append(keys, item.String())
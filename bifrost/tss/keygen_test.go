@@ -9,20 +9,46 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gitlab.com/thorchain/tss/go-tss/blame"
 	. "gopkg.in/check.v1"
 
+	"gitlab.com/thorchain/thornode/bifrost/config"
+	"gitlab.com/thorchain/thornode/bifrost/metrics"
 	"gitlab.com/thorchain/thornode/bifrost/thorclient"
+	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/x/thorchain"
 )
 
 func TestTSSKeyGen(t *testing.T) { TestingT(t) }
 
-type KeyGenTestSuite struct{}
+type KeyGenTestSuite struct {
+	m *metrics.Metrics
+}
 
 var _ = Suite(&KeyGenTestSuite{})
 
-func (*KeyGenTestSuite) SetUpSuite(c *C) {
+var m *metrics.Metrics
+
+func GetMetricForTest(c *C) *metrics.Metrics {
+	if m == nil {
+		var err error
+		m, err = metrics.NewMetrics(config.MetricsConfiguration{
+			Enabled:      false,
+			ListenPort:   9000,
+			ReadTimeout:  time.Second,
+			WriteTimeout: time.Second,
+			Chains:       common.Chains{common.BNBChain},
+		})
+		c.Assert(m, NotNil)
+		c.Assert(err, IsNil)
+	}
+	return m
+}
+
+func (kts *KeyGenTestSuite) SetUpSuite(c *C) {
 	thorchain.SetupConfigForTest()
+	kts.m = GetMetricForTest(c)
 }
 
 const (
@@ -52,7 +78,40 @@ func (kts *KeyGenTestSuite) TestNewTssKenGen(c *C) {
 	k, err := thorclient.NewKeys(folder, signerNameForTest, signerPasswordForTest)
 	c.Assert(err, IsNil)
 	c.Assert(k, NotNil)
-	kg, err := NewTssKeyGen(k, nil)
+	kg, err := NewTssKeyGen(k, nil, nil)
+	c.Assert(err, NotNil)
+	c.Assert(kg, IsNil)
+	kg, err = NewTssKeyGen(k, nil, kts.m)
 	c.Assert(err, IsNil)
 	c.Assert(kg, NotNil)
 }
+
+func (kts *KeyGenTestSuite) TestRecordBlame(c *C) {
+	pk1 := "thorpub1addwnpepqtdklw8tf3anjz7nn5fly3uvq2e67w2apn560s4smmrt9e3x52nt2svmmu3"
+	pk2 := "thorpub1addwnpepqgn4fhz8fxqvxwwq6pn7gxzxjyxdw7gfcqkgvunqmzq9c8p2y5p5ysnelgr"
+	recordBlame(kts.m, ceremonyKeysign, blame.Blame{
+		FailReason: blame.TssTimeout,
+		BlameNodes: []blame.Node{{Pubkey: pk1}, {Pubkey: pk2}},
+	})
+	recordBlame(kts.m, ceremonyKeysign, blame.Blame{
+		FailReason: blame.TssTimeout,
+		BlameNodes: []blame.Node{{Pubkey: pk1}},
+	})
+	counter := kts.m.GetCounterVec(metrics.TSSBlame)
+	c.Check(testutil.ToFloat64(counter.WithLabelValues(ceremonyKeysign, blame.TssTimeout, pk1)), Equals, float64(2))
+	c.Check(testutil.ToFloat64(counter.WithLabelValues(ceremonyKeysign, blame.TssTimeout, pk2)), Equals, float64(1))
+	c.Check(testutil.ToFloat64(counter.WithLabelValues(ceremonyKeygen, blame.TssTimeout, pk1)), Equals, float64(0))
+}
+
+func (kts *KeyGenTestSuite) TestNewKeySign(c *C) {
+	ks, err := NewKeySign(nil, nil)
+	c.Assert(err, NotNil)
+	c.Assert(ks, IsNil)
+	ks, err = NewKeySign(nil, kts.m)
+	c.Assert(err, IsNil)
+	c.Assert(ks, NotNil)
+	// an empty message doesn't need to be signed
+	sig, err := ks.RemoteSign(nil, "", nil)
+	c.Assert(err, IsNil)
+	c.Assert(sig, IsNil)
+}
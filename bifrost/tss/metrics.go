@@ -0,0 +1,27 @@
+package tss
+
+import (
+	"gitlab.com/thorchain/tss/go-tss/blame"
+
+	"gitlab.com/thorchain/thornode/bifrost/metrics"
+)
+
+// results of a TSS ceremony, used to label metrics
+const (
+	resultSuccess     = "success"
+	resultFailure     = "failure"
+	resultNotSelected = "not_selected"
+)
+
+// TSS ceremonies, used to label blame metrics
+const (
+	ceremonyKeygen  = "keygen"
+	ceremonyKeysign = "keysign"
+)
+
+// recordBlame count each node blamed for a failed TSS ceremony, labelled by fail reason and the pubkey of the blamed node
+func recordBlame(m *metrics.Metrics, ceremony string, b blame.Blame) {
+	for _, node := range b.BlameNodes {
+		m.GetCounterVec(metrics.TSSBlame).WithLabelValues(ceremony, b.FailReason, node.Pubkey).Inc()
+	}
+}
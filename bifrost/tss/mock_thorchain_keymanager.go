@@ -2,12 +2,15 @@ package tss
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 
 	ctypes "github.com/binance-chain/go-sdk/common/types"
 	"github.com/binance-chain/go-sdk/keys"
 	"github.com/binance-chain/go-sdk/types/tx"
+	"github.com/tendermint/btcd/btcec"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"gitlab.com/thorchain/thornode/common"
 )
@@ -44,11 +47,26 @@ func (k *MockThorchainKeyManager) SignWithPool(msg tx.StdSignMsg, poolPubKey com
 	return nil, nil
 }
 
+// mockTSSPrivateKey is the private key of the vault MockThorchainKeyManager sign for
+const mockTSSPrivateKey = "b404c5ec58116b5f0fe13464a92e46626fc5db130e418cbce98df86ffe9317c5"
+
 func (k *MockThorchainKeyManager) RemoteSign(msg []byte, poolPubKey string, signerPubKeys common.PubKeys) ([]byte, error) {
 	// this is the key we are using to test TSS keysign result in BTC chain
 	fmt.Println(base64.StdEncoding.EncodeToString(msg))
-	if poolPubKey == "thorpub1addwnpepqts24euwrgly2vtez3zdvusmk6u3cwf8leuzj8m4ynvmv5cst7us2vltqrh" {
-		return getSignature("8RrEI1OG07hiGgRA82/Vfjw5U6OWE6YMrwE9lL5kflM=", "FbNxmjunwFNvwdpzKawW0XbbWSKED8rkt0se4S5KJJk=")
+	buf, err := hex.DecodeString(mockTSSPrivateKey)
+	if err != nil {
+		return nil, err
 	}
-	return nil, nil
+	privateKey, publicKey := btcec.PrivKeyFromBytes(btcec.S256(), buf)
+	var pk secp256k1.PubKeySecp256k1
+	copy(pk[:], publicKey.SerializeCompressed())
+	vaultPubKey, err := common.NewPubKeyFromCrypto(pk)
+	if err != nil || vaultPubKey.String() != poolPubKey {
+		return nil, nil
+	}
+	sig, err := privateKey.Sign(msg)
+	if err != nil {
+		return nil, err
+	}
+	return getSignature(base64.StdEncoding.EncodeToString(sig.R.Bytes()), base64.StdEncoding.EncodeToString(sig.S.Bytes()))
 }
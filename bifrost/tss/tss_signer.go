@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	ctypes "github.com/binance-chain/go-sdk/common/types"
 	"github.com/binance-chain/go-sdk/keys"
@@ -18,20 +19,31 @@ import (
 	"gitlab.com/thorchain/tss/go-tss/keysign"
 	tss "gitlab.com/thorchain/tss/go-tss/tss"
 
+	"gitlab.com/thorchain/thornode/bifrost/metrics"
 	"gitlab.com/thorchain/thornode/common"
 )
 
+// keySignServer is the part of TSS server used by KeySign
+type keySignServer interface {
+	KeySign(req keysign.Request) (keysign.Response, error)
+}
+
 // KeySign is a proxy between signer and TSS
 type KeySign struct {
 	logger zerolog.Logger
-	server *tss.TssServer
+	server keySignServer
+	m      *metrics.Metrics
 }
 
 // NewKeySign create a new instance of KeySign
-func NewKeySign(server *tss.TssServer) (*KeySign, error) {
+func NewKeySign(server *tss.TssServer, m *metrics.Metrics) (*KeySign, error) {
+	if m == nil {
+		return nil, errors.New("metrics is nil")
+	}
 	return &KeySign{
 		server: server,
 		logger: log.With().Str("module", "tss_signer").Logger(),
+		m:      m,
 	}, nil
 }
 
@@ -113,15 +125,27 @@ func (s *KeySign) RemoteSign(msg []byte, poolPubKey string, signerPubKeys common
 	}
 	hashedMsg := crypto.Sha256(msg)
 	encodedMsg := base64.StdEncoding.EncodeToString(hashedMsg)
+	if len(signerPubKeys) > 0 {
+		s.m.GetHistograms(metrics.TSSKeysignPartySize).Observe(float64(len(signerPubKeys)))
+	}
+	start := time.Now()
 	rResult, sResult, err := s.toLocalTSSSigner(poolPubKey, encodedMsg, signerPubKeys)
+	s.m.GetHistograms(metrics.TSSKeysignDuration).Observe(time.Since(start).Seconds())
 	if err != nil {
+		s.m.GetCounterVec(metrics.TSSKeysign).WithLabelValues(resultFailure).Inc()
+		var keysignError KeysignError
+		if errors.As(err, &keysignError) {
+			recordBlame(s.m, ceremonyKeysign, keysignError.Blame)
+		}
 		return nil, fmt.Errorf("fail to tss sign: %w", err)
 	}
 
 	if len(rResult) == 0 && len(sResult) == 0 {
 		// this means the node tried to do keygen , however this node has not been chosen to take part in the keysign committee
+		s.m.GetCounterVec(metrics.TSSKeysign).WithLabelValues(resultNotSelected).Inc()
 		return nil, nil
 	}
+	s.m.GetCounterVec(metrics.TSSKeysign).WithLabelValues(resultSuccess).Inc()
 	s.logger.Debug().Str("R", rResult).Str("S", sResult).Msg("tss result")
 	data, err := getSignature(rResult, sResult)
 	if err != nil {
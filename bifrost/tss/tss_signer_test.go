@@ -0,0 +1,103 @@
+package tss
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gitlab.com/thorchain/tss/go-tss/blame"
+	tsscommon "gitlab.com/thorchain/tss/go-tss/common"
+	"gitlab.com/thorchain/tss/go-tss/keysign"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type KeySignTestSuite struct{}
+
+var _ = Suite(&KeySignTestSuite{})
+
+// mockKeySignServer only answers once all the expected keysign requests arrived,
+// requests that are not sent concurrently will time out
+type mockKeySignServer struct {
+	lock     *sync.Mutex
+	expected int
+	requests []keysign.Request
+	ready    chan struct{}
+	fail     bool
+}
+
+func newMockKeySignServer(expected int) *mockKeySignServer {
+	return &mockKeySignServer{
+		lock:     &sync.Mutex{},
+		expected: expected,
+		ready:    make(chan struct{}),
+	}
+}
+
+func (s *mockKeySignServer) KeySign(req keysign.Request) (keysign.Response, error) {
+	s.lock.Lock()
+	s.requests = append(s.requests, req)
+	if len(s.requests) == s.expected {
+		close(s.ready)
+	}
+	s.lock.Unlock()
+	select {
+	case <-s.ready:
+	case <-time.After(time.Second):
+		return keysign.Response{}, errors.New("keysign request is not sent concurrently")
+	}
+	if s.fail {
+		return keysign.NewResponse("", "", tsscommon.Fail, blame.NewBlame(blame.HashCheckFail, []blame.Node{
+			{Pubkey: "thorpub1addwnpepqfup3y8p0egd7ml7vrnlxgl3wvnp89mpn0tjpj0p2nm2gh0n9hlrvrtylay"},
+		})), nil
+	}
+	return keysign.NewResponse("8RrEI1OG07hiGgRA82/Vfjw5U6OWE6YMrwE9lL5kflM=", "FbNxmjunwFNvwdpzKawW0XbbWSKED8rkt0se4S5KJJk=", tsscommon.Success, blame.Blame{}), nil
+}
+
+func (s *KeySignTestSuite) remoteSign(ks *KeySign, msgs []string, poolPubKey string) ([][]byte, []error) {
+	signers := common.PubKeys{common.PubKey("thorpub1addwnpepqfup3y8p0egd7ml7vrnlxgl3wvnp89mpn0tjpj0p2nm2gh0n9hlrvrtylay")}
+	sigs := make([][]byte, len(msgs))
+	errs := make([]error, len(msgs))
+	wg := &sync.WaitGroup{}
+	for i, msg := range msgs {
+		wg.Add(1)
+		go func(idx int, msg string) {
+			defer wg.Done()
+			sigs[idx], errs[idx] = ks.RemoteSign([]byte(msg), poolPubKey, signers)
+		}(i, msg)
+	}
+	wg.Wait()
+	return sigs, errs
+}
+
+func (s *KeySignTestSuite) TestRemoteSign(c *C) {
+	m := GetMetricForTest(c)
+	ks, err := NewKeySign(nil, m)
+	c.Assert(err, IsNil)
+	server := newMockKeySignServer(3)
+	ks.server = server
+	success := testutil.ToFloat64(m.GetCounterVec(metrics.TSSKeysign).WithLabelValues(resultSuccess))
+
+	// concurrent keysign requests are not serialised, each one is sent to TSS straight away
+	sigs, errs := s.remoteSign(ks, []string{"one", "two", "three"}, "thorpub1addwnpepqts24euwrgly2vtez3zdvusmk6u3cwf8leuzj8m4ynvmv5cst7us2vltqrh")
+	for i := range sigs {
+		c.Assert(errs[i], IsNil)
+		c.Assert(sigs[i], HasLen, 64)
+	}
+	c.Assert(server.requests, HasLen, 3)
+	c.Check(testutil.ToFloat64(m.GetCounterVec(metrics.TSSKeysign).WithLabelValues(resultSuccess)), Equals, success+3)
+
+	// a failed keysign return the blame
+	server = newMockKeySignServer(2)
+	server.fail = true
+	ks.server = server
+	_, errs = s.remoteSign(ks, []string{"one", "two"}, "thorpub1addwnpepqts24euwrgly2vtez3zdvusmk6u3cwf8leuzj8m4ynvmv5cst7us2vltqrh")
+	for _, err := range errs {
+		var keysignError KeysignError
+		c.Assert(errors.As(err, &keysignError), Equals, true)
+		c.Assert(keysignError.Blame.BlameNodes, HasLen, 1)
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -11,20 +12,29 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"gitlab.com/thorchain/tss/go-tss/tss"
+
+	"gitlab.com/thorchain/thornode/bifrost/solvency"
 )
 
+// SolvencyReporter provide the latest vault solvency report
+type SolvencyReporter interface {
+	GetReport() solvency.Report
+}
+
 // HealthServer to provide something for health check and also p2pid
 type HealthServer struct {
-	logger    zerolog.Logger
-	s         *http.Server
-	tssServer tss.Server
+	logger           zerolog.Logger
+	s                *http.Server
+	tssServer        tss.Server
+	solvencyReporter SolvencyReporter
 }
 
 // NewHealthServer create a new instance of health server
-func NewHealthServer(addr string, tssServer tss.Server) *HealthServer {
+func NewHealthServer(addr string, tssServer tss.Server, solvencyReporter SolvencyReporter) *HealthServer {
 	hs := &HealthServer{
-		logger:    log.With().Str("module", "http").Logger(),
-		tssServer: tssServer,
+		logger:           log.With().Str("module", "http").Logger(),
+		tssServer:        tssServer,
+		solvencyReporter: solvencyReporter,
 	}
 	s := &http.Server{
 		Addr:    addr,
@@ -38,6 +48,7 @@ func (s *HealthServer) newHandler() http.Handler {
 	router := mux.NewRouter()
 	router.Handle("/ping", http.HandlerFunc(s.pingHandler)).Methods(http.MethodGet)
 	router.Handle("/p2pid", http.HandlerFunc(s.getP2pIDHandler)).Methods(http.MethodGet)
+	router.Handle("/solvency", http.HandlerFunc(s.solvencyHandler)).Methods(http.MethodGet)
 	return router
 }
 
@@ -53,6 +64,25 @@ func (t *HealthServer) getP2pIDHandler(w http.ResponseWriter, _ *http.Request) {
 	}
 }
 
+// solvencyHandler return the latest vault solvency report, with status code
+// 503 when a vault has been insolvent for solvency.failure_threshold checks in a row
+func (t *HealthServer) solvencyHandler(w http.ResponseWriter, _ *http.Request) {
+	report := t.solvencyReporter.GetReport()
+	buf, err := json.Marshal(report)
+	if err != nil {
+		t.logger.Error().Err(err).Msg("fail to marshal solvency report")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if !report.Solvent {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if _, err := w.Write(buf); err != nil {
+		t.logger.Error().Err(err).Msg("fail to write to response")
+	}
+}
+
 // Start health server
 func (t *HealthServer) Start() error {
 	if t.s == nil {
@@ -14,6 +14,8 @@ import (
 	"gitlab.com/thorchain/tss/go-tss/keygen"
 	"gitlab.com/thorchain/tss/go-tss/keysign"
 	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/bifrost/solvency"
 )
 
 func TestPackage(t *testing.T) { TestingT(t) }
@@ -62,6 +64,14 @@ func (mts *MockTssServer) GetStatus() common.TssStatus {
 	}
 }
 
+type MockSolvencyReporter struct {
+	report solvency.Report
+}
+
+func (m *MockSolvencyReporter) GetReport() solvency.Report {
+	return m.report
+}
+
 type HealthServerTestSuite struct {
 }
 
@@ -69,7 +79,7 @@ var _ = Suite(&HealthServerTestSuite{})
 
 func (HealthServerTestSuite) TestHealthServer(c *C) {
 	tssServer := &MockTssServer{}
-	s := NewHealthServer("127.0.0.1:8080", tssServer)
+	s := NewHealthServer("127.0.0.1:8080", tssServer, &MockSolvencyReporter{})
 	c.Assert(s, NotNil)
 	wg := sync.WaitGroup{}
 	wg.Add(1)
@@ -84,7 +94,7 @@ func (HealthServerTestSuite) TestHealthServer(c *C) {
 
 func (HealthServerTestSuite) TestPingHandler(c *C) {
 	tssServer := &MockTssServer{}
-	s := NewHealthServer("127.0.0.1:8080", tssServer)
+	s := NewHealthServer("127.0.0.1:8080", tssServer, &MockSolvencyReporter{})
 	c.Assert(s, NotNil)
 	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
 	res := httptest.NewRecorder()
@@ -94,10 +104,27 @@ func (HealthServerTestSuite) TestPingHandler(c *C) {
 
 func (HealthServerTestSuite) TestGetP2pIDHandler(c *C) {
 	tssServer := &MockTssServer{}
-	s := NewHealthServer("127.0.0.1:8080", tssServer)
+	s := NewHealthServer("127.0.0.1:8080", tssServer, &MockSolvencyReporter{})
 	c.Assert(s, NotNil)
 	req := httptest.NewRequest(http.MethodGet, "/p2pid", nil)
 	res := httptest.NewRecorder()
 	s.getP2pIDHandler(res, req)
 	c.Assert(res.Code, Equals, http.StatusOK)
 }
+
+func (HealthServerTestSuite) TestSolvencyHandler(c *C) {
+	reporter := &MockSolvencyReporter{
+		report: solvency.Report{Solvent: true},
+	}
+	s := NewHealthServer("127.0.0.1:8080", &MockTssServer{}, reporter)
+	c.Assert(s, NotNil)
+	req := httptest.NewRequest(http.MethodGet, "/solvency", nil)
+	res := httptest.NewRecorder()
+	s.solvencyHandler(res, req)
+	c.Assert(res.Code, Equals, http.StatusOK)
+
+	reporter.report.Solvent = false
+	res = httptest.NewRecorder()
+	s.solvencyHandler(res, req)
+	c.Assert(res.Code, Equals, http.StatusServiceUnavailable)
+}
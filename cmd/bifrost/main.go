@@ -29,6 +29,7 @@ import (
 	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients"
 	"gitlab.com/thorchain/thornode/bifrost/pubkeymanager"
 	"gitlab.com/thorchain/thornode/bifrost/signer"
+	"gitlab.com/thorchain/thornode/bifrost/solvency"
 	"gitlab.com/thorchain/thornode/bifrost/thorclient"
 	"gitlab.com/thorchain/thornode/cmd"
 )
@@ -130,13 +131,6 @@ func main() {
 		log.Err(err).Msg("fail to start tss instance")
 	}
 
-	healthServer := NewHealthServer(cfg.TSS.InfoAddress, tssIns)
-	go func() {
-		defer log.Info().Msg("health server exit")
-		if err := healthServer.Start(); err != nil {
-			log.Error().Err(err).Msg("fail to start health server")
-		}
-	}()
 	if len(cfg.Chains) == 0 {
 		log.Fatal().Err(err).Msg("missing chains")
 		return
@@ -163,6 +157,23 @@ func main() {
 
 	chains := chainclients.LoadChains(thorKeys, cfg.Chains, tssIns, thorchainBridge, m)
 
+	// start solvency checker
+	solvencyChecker, err := solvency.NewSolvencyChecker(cfg.Solvency, thorchainBridge, chains, m)
+	if err != nil {
+		log.Fatal().Err(err).Msg("fail to create solvency checker")
+	}
+	if err := solvencyChecker.Start(); err != nil {
+		log.Fatal().Err(err).Msg("fail to start solvency checker")
+	}
+
+	healthServer := NewHealthServer(cfg.TSS.InfoAddress, tssIns, solvencyChecker)
+	go func() {
+		defer log.Info().Msg("health server exit")
+		if err := healthServer.Start(); err != nil {
+			log.Error().Err(err).Msg("fail to start health server")
+		}
+	}()
+
 	// start observer
 	obs, err := observer.NewObserver(pubkeyMgr, chains, thorchainBridge, m)
 	if err != nil {
@@ -195,6 +206,10 @@ func main() {
 	if err := sign.Stop(); err != nil {
 		log.Fatal().Err(err).Msg("fail to stop signer")
 	}
+	// stop solvency checker
+	if err := solvencyChecker.Stop(); err != nil {
+		log.Fatal().Err(err).Msg("fail to stop solvency checker")
+	}
 	// stop go tss
 	tssIns.Stop()
 	if err := healthServer.Stop(); err != nil {
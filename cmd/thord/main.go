@@ -4,13 +4,17 @@ import (
 	"encoding/json"
 	"io"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/genaccounts"
 	genaccscli "github.com/cosmos/cosmos-sdk/x/genaccounts/client/cli"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/cli"
 	"github.com/tendermint/tendermint/libs/log"
@@ -21,6 +25,12 @@ import (
 	cmd "gitlab.com/thorchain/thornode/cmd"
 )
 
+const (
+	flagPruning           = "pruning"
+	flagPruningKeepRecent = "pruning-keep-recent"
+	flagPruningKeepEvery  = "pruning-keep-every"
+)
+
 func main() {
 	cobra.EnableCommandSorting = false
 
@@ -50,6 +60,12 @@ func main() {
 	)
 
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
+	for _, c := range rootCmd.Commands() {
+		if c.Name() == "start" {
+			c.Flags().Int64(flagPruningKeepRecent, 0, "Number of recent states to keep, overrides the pruning strategy when above 0")
+			c.Flags().Int64(flagPruningKeepEvery, 0, "Keep every nth state on top of the recent ones, used with --pruning-keep-recent")
+		}
+	}
 
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "SS", app.DefaultNodeHome)
@@ -60,7 +76,18 @@ func main() {
 }
 
 func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
-	return app.NewThorchainApp(logger, db)
+	return app.NewThorchainApp(logger, db, baseapp.SetPruning(pruningOptions()))
+}
+
+// pruningOptions returns how many of the past states thord keeps to serve
+// queries at past heights, set by the pruning strategy (--pruning), or by the
+// number of recent states to keep (--pruning-keep-recent)
+func pruningOptions() store.PruningOptions {
+	keepRecent := viper.GetInt64(flagPruningKeepRecent)
+	if keepRecent > 0 {
+		return storetypes.NewPruningOptions(keepRecent, viper.GetInt64(flagPruningKeepEvery))
+	}
+	return store.NewPruningOptionsFromString(viper.GetString(flagPruning))
 }
 
 func exportAppStateAndTMValidators(
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	. "gopkg.in/check.v1"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type MainSuite struct{}
+
+var _ = Suite(&MainSuite{})
+
+func (s *MainSuite) TearDownTest(c *C) {
+	viper.Reset()
+}
+
+func (s *MainSuite) TestPruningOptions(c *C) {
+	viper.Set(flagPruning, "syncable")
+	opts := pruningOptions()
+	c.Check(opts.KeepRecent(), Equals, int64(100))
+	c.Check(opts.KeepEvery(), Equals, int64(10000))
+
+	viper.Set(flagPruning, "nothing")
+	opts = pruningOptions()
+	c.Check(opts.KeepRecent(), Equals, int64(0))
+	c.Check(opts.KeepEvery(), Equals, int64(1))
+
+	// keeping recent states overrides the pruning strategy
+	viper.Set(flagPruningKeepRecent, 200)
+	viper.Set(flagPruningKeepEvery, 720)
+	opts = pruningOptions()
+	c.Check(opts.KeepRecent(), Equals, int64(200))
+	c.Check(opts.KeepEvery(), Equals, int64(720))
+}
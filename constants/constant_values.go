@@ -39,6 +39,21 @@ const (
 	FailKeygenSlashPoints
 	FailKeySignSlashPoints
 	StakeLockUpBlocks
+	SlashPointHalfLife
+	JailTimeKeysign
+	KeysignFailuresToJail
+	KeysignFailureWindow
+	AsgardSize
+	ObservationThreshold
+	LargeObservationThreshold
+	LargeObservationRuneValue
+	YggFundLimit
+	MinimumBondToYggRatio
+	YggRecallBuffer
+	NetworkFeeReportExpiry
+	TNSRegisterFee
+	TNSFeePerBlock
+	PendingLiquidityExpiry
 )
 
 var nameToString = map[ConstantName]string{
@@ -71,6 +86,21 @@ var nameToString = map[ConstantName]string{
 	FailKeygenSlashPoints:           "FailKeygenSlashPoints",
 	FailKeySignSlashPoints:          "FailKeySignSlashPoints",
 	StakeLockUpBlocks:               "StakeLockUpBlocks",
+	SlashPointHalfLife:              "SlashPointHalfLife",
+	JailTimeKeysign:                 "JailTimeKeysign",
+	KeysignFailuresToJail:           "KeysignFailuresToJail",
+	KeysignFailureWindow:            "KeysignFailureWindow",
+	AsgardSize:                      "AsgardSize",
+	ObservationThreshold:            "ObservationThreshold",
+	LargeObservationThreshold:       "LargeObservationThreshold",
+	LargeObservationRuneValue:       "LargeObservationRuneValue",
+	YggFundLimit:                    "YggFundLimit",
+	MinimumBondToYggRatio:           "MinimumBondToYggRatio",
+	YggRecallBuffer:                 "YggRecallBuffer",
+	NetworkFeeReportExpiry:          "NetworkFeeReportExpiry",
+	TNSRegisterFee:                  "TNSRegisterFee",
+	TNSFeePerBlock:                  "TNSFeePerBlock",
+	PendingLiquidityExpiry:          "PendingLiquidityExpiry",
 }
 
 // String implement fmt.stringer
@@ -30,6 +30,21 @@ func (ConstantsTestSuite) TestConstantName_String(c *C) {
 		SigningTransactionPeriod,
 		DoubleSignMaxAge,
 		MinimumBondInRune,
+		SlashPointHalfLife,
+		JailTimeKeysign,
+		KeysignFailuresToJail,
+		KeysignFailureWindow,
+		AsgardSize,
+		ObservationThreshold,
+		LargeObservationThreshold,
+		LargeObservationRuneValue,
+		YggFundLimit,
+		MinimumBondToYggRatio,
+		YggRecallBuffer,
+		NetworkFeeReportExpiry,
+		TNSRegisterFee,
+		TNSFeePerBlock,
+		PendingLiquidityExpiry,
 	}
 	for _, item := range constantNames {
 		c.Assert(item.String(), Not(Equals), "NA")
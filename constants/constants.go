@@ -53,6 +53,21 @@ func NewConstantValue010() *ConstantValue010 {
 			FailKeygenSlashPoints:           720,                 // slash for 720 blocks , which equals 1 hour
 			FailKeySignSlashPoints:          2,                   // slash for 2 blocks
 			StakeLockUpBlocks:               17280,               // the number of blocks staker can unstake after their stake
+			SlashPointHalfLife:              0,                   // number of blocks for slash points to halve, 0 means slash points don't decay
+			JailTimeKeysign:                 720,                 // number of blocks a node account stays in jail for failing keysign repeatedly
+			KeysignFailuresToJail:           3,                   // number of keysign failures within KeysignFailureWindow blocks that get a node account jailed
+			KeysignFailureWindow:            720,                 // number of blocks keysign failures are counted over, the count restarts after that
+			AsgardSize:                      40,                  // maximum number of node accounts in one asgard vault, active nodes are split into multiple asgard vaults beyond that
+			ObservationThreshold:            0,                   // share of active nodes (basis points) required to observe an inbound tx, can be set per chain with mimir, never lower than 2/3 supermajority
+			LargeObservationThreshold:       9000,                // share of active nodes (basis points) required to observe a large inbound tx, lower it with mimir to let a stuck large inbound tx through
+			LargeObservationRuneValue:       0,                   // RUNE value from which an inbound tx is large, 0 means disabled
+			YggFundLimit:                    5000,                // maximum share of node bond (basis points) a yggdrasil vault can hold in assets of a single chain, can be set per chain with mimir
+			MinimumBondToYggRatio:           15000,               // minimum ratio (basis points) of node bond to yggdrasil value, funds above it are recalled, 0 means disabled
+			YggRecallBuffer:                 1000,                // share (basis points) of a yggdrasil limit the value can go above before the excess is recalled, so price moves don't cause dust recalls
+			NetworkFeeReportExpiry:          720,                 // number of blocks a network fee reported by a node is taken into account, nodes report their fee rate again before that
+			TNSRegisterFee:                  1_000_000_000,       // 10 rune to register a THORName
+			TNSFeePerBlock:                  20,                  // rune (1e8) a THORName costs per block it stays registered, ~1.26 rune per year
+			PendingLiquidityExpiry:          17280,               // number of blocks the first leg of a cross chain stake waits for the other leg, ~1 day
 		},
 		boolValues: map[ConstantName]bool{
 			StrictBondStakeRatio: true,
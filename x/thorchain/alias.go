@@ -3,6 +3,7 @@ package thorchain
 import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 
+	mem "gitlab.com/thorchain/thornode/x/thorchain/memo"
 	"gitlab.com/thorchain/thornode/x/thorchain/types"
 )
 
@@ -27,7 +28,9 @@ const (
 	RefundStatus = types.Refund
 
 	// Admin config keys
-	MaxUnstakeBasisPoints = types.MaxUnstakeBasisPoints
+	MaxUnstakeBasisPoints      = types.MaxUnstakeBasisPoints
+	MaxOperatorFeeBasisPoints  = types.MaxOperatorFeeBasisPoints
+	MaxAffiliateFeeBasisPoints = types.MaxAffiliateFeeBasisPoints
 
 	// Vaults
 	AsgardVault    = types.AsgardVault
@@ -35,6 +38,7 @@ const (
 	ActiveVault    = types.ActiveVault
 	InactiveVault  = types.InactiveVault
 	RetiringVault  = types.RetiringVault
+	InitVault      = types.InitVault
 
 	// Node status
 	NodeActive      = types.Active
@@ -48,6 +52,34 @@ const (
 	BondPaid     = types.BondPaid
 	BondReturned = types.BondReturned
 	AsgardKeygen = types.AsgardKeygen
+
+	// Slash reasons
+	SlashReasonLackOfObservation = types.SlashReasonLackOfObservation
+	SlashReasonFailToSign        = types.SlashReasonFailToSign
+	SlashReasonFailKeygen        = types.SlashReasonFailKeygen
+	SlashReasonFailKeysign       = types.SlashReasonFailKeysign
+	SlashReasonDoubleSign        = types.SlashReasonDoubleSign
+	SlashReasonExcessOutbound    = types.SlashReasonExcessOutbound
+	SlashReasonYggdrasilLeftover = types.SlashReasonYggdrasilLeftover
+
+	// Memo tx types
+	TxUnknown         = mem.TxUnknown
+	TxStake           = mem.TxStake
+	TxUnstake         = mem.TxUnstake
+	TxSwap            = mem.TxSwap
+	TxOutbound        = mem.TxOutbound
+	TxAdd             = mem.TxAdd
+	TxBond            = mem.TxBond
+	TxLeave           = mem.TxLeave
+	TxYggdrasilFund   = mem.TxYggdrasilFund
+	TxYggdrasilReturn = mem.TxYggdrasilReturn
+	TxReserve         = mem.TxReserve
+	TxRefund          = mem.TxRefund
+	TxMigrate         = mem.TxMigrate
+	TxRagnarok        = mem.TxRagnarok
+	TxSwitch          = mem.TxSwitch
+	TxUnbond          = mem.TxUnbond
+	TxConsolidate     = mem.TxConsolidate
 )
 
 var (
@@ -89,6 +121,7 @@ var (
 	NewEventErrata                 = types.NewEventErrata
 	NewEventFee                    = types.NewEventFee
 	NewEventOutbound               = types.NewEventOutbound
+	NewEventJail                   = types.NewEventJail
 	NewPoolMod                     = types.NewPoolMod
 	NewMsgRefundTx                 = types.NewMsgRefundTx
 	NewMsgOutboundTx               = types.NewMsgOutboundTx
@@ -96,6 +129,8 @@ var (
 	NewMsgRagnarok                 = types.NewMsgRagnarok
 	NewQueryNodeAccount            = types.NewQueryNodeAccount
 	HasSuperMajority               = types.HasSuperMajority
+	HasSimpleMajority              = types.HasSimpleMajority
+	HasThreshold                   = types.HasThreshold
 	ChooseSignerParty              = types.ChooseSignerParty
 	GetThreshold                   = types.GetThreshold
 	ModuleCdc                      = types.ModuleCdc
@@ -103,6 +138,19 @@ var (
 	NewNodeAccount                 = types.NewNodeAccount
 	NewVault                       = types.NewVault
 	NewReserveContributor          = types.NewReserveContributor
+	NewBondProvider                = types.NewBondProvider
+	NewSlashRecord                 = types.NewSlashRecord
+	NewObserverStats               = types.NewObserverStats
+	NewMigrationPlan               = types.NewMigrationPlan
+	NewNetworkFee                  = types.NewNetworkFee
+	NewMsgNetworkFee               = types.NewMsgNetworkFee
+	NewMemoRef                     = types.NewMemoRef
+	NewMsgRegisterMemo             = types.NewMsgRegisterMemo
+	ParseMemoRef                   = types.ParseMemoRef
+	NewTHORName                    = types.NewTHORName
+	NewMsgManageTHORName           = types.NewMsgManageTHORName
+	IsValidTHORName                = types.IsValidTHORName
+	NewPendingLiquidity            = types.NewPendingLiquidity
 	NewMsgYggdrasil                = types.NewMsgYggdrasil
 	NewMsgReserveContributor       = types.NewMsgReserveContributor
 	NewMsgBond                     = types.NewMsgBond
@@ -110,9 +158,11 @@ var (
 	NewMsgBan                      = types.NewMsgBan
 	NewMsgSwitch                   = types.NewMsgSwitch
 	NewMsgLeave                    = types.NewMsgLeave
+	NewMsgUnbond                   = types.NewMsgUnbond
 	NewMsgSetVersion               = types.NewMsgSetVersion
 	NewMsgSetIPAddress             = types.NewMsgSetIPAddress
 	GetPoolStatus                  = types.GetPoolStatus
+	GetNodeStatus                  = types.GetNodeStatus
 	GetRandomVault                 = types.GetRandomVault
 	GetRandomTx                    = types.GetRandomTx
 	GetRandomObservedTx            = types.GetRandomObservedTx
@@ -128,6 +178,27 @@ var (
 	GetRandomPubKeySet             = types.GetRandomPubKeySet
 	SetupConfigForTest             = types.SetupConfigForTest
 	GetEventStatuses               = types.GetEventStatuses
+
+	// Memos
+	ParseMemo           = mem.ParseMemo
+	ParseMemoWithParser = mem.ParseMemoWithParser
+	StringToTxType      = mem.StringToTxType
+	NewAddMemo          = mem.NewAddMemo
+	NewBondMemo         = mem.NewBondMemo
+	NewConsolidateMemo  = mem.NewConsolidateMemo
+	NewLeaveMemo        = mem.NewLeaveMemo
+	NewMigrateMemo      = mem.NewMigrateMemo
+	NewOutboundMemo     = mem.NewOutboundMemo
+	NewRagnarokMemo     = mem.NewRagnarokMemo
+	NewRefundMemo       = mem.NewRefundMemo
+	NewReserveMemo      = mem.NewReserveMemo
+	NewStakeMemo        = mem.NewStakeMemo
+	NewSwapMemo         = mem.NewSwapMemo
+	NewSwitchMemo       = mem.NewSwitchMemo
+	NewUnbondMemo       = mem.NewUnbondMemo
+	NewUnstakeMemo      = mem.NewUnstakeMemo
+	NewYggdrasilFund    = mem.NewYggdrasilFund
+	NewYggdrasilReturn  = mem.NewYggdrasilReturn
 )
 
 type (
@@ -151,17 +222,22 @@ type (
 	MsgSetIPAddress       = types.MsgSetIPAddress
 	MsgSetNodeKeys        = types.MsgSetNodeKeys
 	MsgLeave              = types.MsgLeave
+	MsgUnbond             = types.MsgUnbond
 	MsgReserveContributor = types.MsgReserveContributor
 	MsgYggdrasil          = types.MsgYggdrasil
 	MsgObservedTxIn       = types.MsgObservedTxIn
 	MsgObservedTxOut      = types.MsgObservedTxOut
 	MsgTssPool            = types.MsgTssPool
 	MsgTssKeysignFail     = types.MsgTssKeysignFail
+	MsgNetworkFee         = types.MsgNetworkFee
+	MsgRegisterMemo       = types.MsgRegisterMemo
+	MsgManageTHORName     = types.MsgManageTHORName
 	QueryResPools         = types.QueryResPools
 	QueryResHeights       = types.QueryResHeights
 	QueryResTxOut         = types.QueryResTxOut
 	QueryYggdrasilVaults  = types.QueryYggdrasilVaults
 	QueryNodeAccount      = types.QueryNodeAccount
+	QueryResSlashes       = types.QueryResSlashes
 	ResTxOut              = types.ResTxOut
 	NodeKeys              = types.NodeKeys
 	NodesKeys             = types.NodesKeys
@@ -197,6 +273,25 @@ type (
 	PoolMods              = types.PoolMods
 	ReserveContributor    = types.ReserveContributor
 	ReserveContributors   = types.ReserveContributors
+	BondProvider          = types.BondProvider
+	BondProviders         = types.BondProviders
+	SlashReason           = types.SlashReason
+	SlashRecord           = types.SlashRecord
+	SlashRecords          = types.SlashRecords
+	ObserverStats         = types.ObserverStats
+	ObserverChainStats    = types.ObserverChainStats
+	QueryResObserverStats = types.QueryResObserverStats
+	QueryObserverChain    = types.QueryObserverChain
+	MigrationPlan         = types.MigrationPlan
+	NetworkFee            = types.NetworkFee
+	NetworkFeeReport      = types.NetworkFeeReport
+	NetworkFeeReports     = types.NetworkFeeReports
+	MemoRef               = types.MemoRef
+	THORName              = types.THORName
+	THORNameAlias         = types.THORNameAlias
+	PendingLiquidity      = types.PendingLiquidity
+	MigrationItem         = types.MigrationItem
+	QueryResMigration     = types.QueryResMigration
 	Vault                 = types.Vault
 	Vaults                = types.Vaults
 	NodeAccount           = types.NodeAccount
@@ -215,4 +310,30 @@ type (
 	EventFee              = types.EventFee
 	EventSlash            = types.EventSlash
 	EventOutbound         = types.EventOutbound
+	EventJail             = types.EventJail
+
+	// Memos
+	TxType              = mem.TxType
+	Memo                = mem.Memo
+	MemoBase            = mem.MemoBase
+	AddressParser       = mem.AddressParser
+	AddMemo             = mem.AddMemo
+	AdminMemo           = mem.AdminMemo
+	BondMemo            = mem.BondMemo
+	ConsolidateMemo     = mem.ConsolidateMemo
+	CreateMemo          = mem.CreateMemo
+	GasMemo             = mem.GasMemo
+	LeaveMemo           = mem.LeaveMemo
+	MigrateMemo         = mem.MigrateMemo
+	OutboundMemo        = mem.OutboundMemo
+	RagnarokMemo        = mem.RagnarokMemo
+	RefundMemo          = mem.RefundMemo
+	ReserveMemo         = mem.ReserveMemo
+	StakeMemo           = mem.StakeMemo
+	SwapMemo            = mem.SwapMemo
+	SwitchMemo          = mem.SwitchMemo
+	UnbondMemo          = mem.UnbondMemo
+	UnstakeMemo         = mem.UnstakeMemo
+	YggdrasilFundMemo   = mem.YggdrasilFundMemo
+	YggdrasilReturnMemo = mem.YggdrasilReturnMemo
 )
@@ -1,15 +1,30 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
 
 	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/query"
 	"gitlab.com/thorchain/thornode/x/thorchain/types"
 )
 
+const (
+	flagTable  = "table"
+	flagParams = "params"
+)
+
 type ver struct {
 	Version string `json:"version"`
 }
@@ -26,9 +41,11 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	thorchainQueryCmd.AddCommand(client.GetCommands(
-		GetCmdGetVersion(storeKey, cdc),
-	)...)
+	cmds := []*cobra.Command{GetCmdGetVersion(storeKey, cdc)}
+	for _, q := range query.Queries {
+		cmds = append(cmds, GetCmdQuery(storeKey, q))
+	}
+	thorchainQueryCmd.AddCommand(client.GetCommands(cmds...)...)
 	return thorchainQueryCmd
 }
 
@@ -46,3 +63,148 @@ func GetCmdGetVersion(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQuery creates the command of the given query, named after its Use with
+// its key as an alias, the query args are the command args, the result is
+// printed as json, or as a table with --table
+func GetCmdQuery(storeKey string, q query.Query) *cobra.Command {
+	use := q.Use
+	var aliases []string
+	if use == "" {
+		use = q.Key
+	} else if use != q.Key {
+		aliases = append(aliases, q.Key)
+	}
+	placeholders := []string{storeKey}
+	for _, arg := range q.Args {
+		use += fmt.Sprintf(" [%s]", arg)
+		placeholders = append(placeholders, arg)
+	}
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   fmt.Sprintf("Queries %s", q.Endpoint(placeholders...)),
+		Args:    cobra.ExactArgs(len(q.Args)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext()
+
+			// send the params the way the rest api sends its query string
+			params, err := cmd.Flags().GetString(flagParams)
+			if err != nil {
+				return err
+			}
+			var data []byte
+			if params != "" {
+				u := url.URL{Path: q.Endpoint(append([]string{storeKey}, args...)...), RawQuery: params}
+				data, err = u.MarshalBinary()
+				if err != nil {
+					return fmt.Errorf("fail to marshal query params: %w", err)
+				}
+			}
+
+			res, _, err := cliCtx.QueryWithData(q.Path(append([]string{storeKey}, args...)...), data)
+			if err != nil {
+				return err
+			}
+			table, err := cmd.Flags().GetBool(flagTable)
+			if err != nil {
+				return err
+			}
+			if table {
+				return printTable(cmd.OutOrStdout(), res)
+			}
+			var out bytes.Buffer
+			if err := json.Indent(&out, res, "", "  "); err != nil {
+				return fmt.Errorf("fail to indent query result: %w", err)
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), out.String())
+			return err
+		},
+	}
+	cmd.Flags().Bool(flagTable, false, "Print the result as a table instead of json")
+	cmd.Flags().String(flagParams, "", "Query string params of the query, e.g. \"status=enabled&limit=10\"")
+	return cmd
+}
+
+// printTable prints a json array of objects as one row per object, and a json
+// object as one row per field. Nested values are printed as compact json
+func printTable(w io.Writer, res []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(res))
+	dec.UseNumber()
+	var result interface{}
+	if err := dec.Decode(&result); err != nil {
+		return fmt.Errorf("fail to decode query result: %w", err)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	switch r := result.(type) {
+	case []interface{}:
+		columns := tableColumns(r)
+		if len(columns) == 0 {
+			for _, item := range r {
+				fmt.Fprintln(tw, tableCell(item))
+			}
+			break
+		}
+		fmt.Fprintln(tw, strings.ToUpper(strings.Join(columns, "\t")))
+		for _, item := range r {
+			obj, _ := item.(map[string]interface{})
+			cells := make([]string, len(columns))
+			for i, column := range columns {
+				cells[i] = tableCell(obj[column])
+			}
+			fmt.Fprintln(tw, strings.Join(cells, "\t"))
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(r))
+		for key := range r {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(tw, "%s\t%s\n", key, tableCell(r[key]))
+		}
+	default:
+		fmt.Fprintln(tw, tableCell(r))
+	}
+	return tw.Flush()
+}
+
+// tableColumns returns the sorted keys of all the objects in the given array
+func tableColumns(items []interface{}) []string {
+	seen := make(map[string]bool)
+	var columns []string
+	for _, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key := range obj {
+			if !seen[key] {
+				seen[key] = true
+				columns = append(columns, key)
+			}
+		}
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+func tableCell(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return fmt.Sprintf("%t", v)
+	default:
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(buf)
+	}
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/x/thorchain/query"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type QuerySuite struct{}
+
+var _ = Suite(&QuerySuite{})
+
+func (s *QuerySuite) TestGetCmdQuery(c *C) {
+	cmd := GetCmdQuery("thorchain", query.QueryVaultsAsgard)
+	c.Check(cmd.Use, Equals, "asgard-vaults")
+	c.Check(cmd.Aliases, DeepEquals, []string{"vaultsasgard"})
+
+	cmd = GetCmdQuery("thorchain", query.QueryKeysignArrayPubkey)
+	c.Check(cmd.Use, Equals, "keysign-pubkey [height] [pubkey]")
+	c.Check(cmd.Short, Equals, "Queries /thorchain/keysign/{height}/{pubkey}")
+
+	// the key is the name when it is the use too
+	cmd = GetCmdQuery("thorchain", query.QueryPool)
+	c.Check(cmd.Use, Equals, "pool [asset]")
+	c.Check(cmd.Aliases, HasLen, 0)
+}
+
+func (s *QuerySuite) TestPrintTable(c *C) {
+	var out bytes.Buffer
+	res := []byte(`[{"asset":"BNB.BNB","balance_rune":"100","status":"Enabled"},{"asset":"BTC.BTC","balance_rune":"2000","status":"Bootstrap","extra":{"a":1}}]`)
+	c.Assert(printTable(&out, res), IsNil)
+	c.Check(out.String(), Equals, ""+
+		"ASSET    BALANCE_RUNE  EXTRA    STATUS\n"+
+		"BNB.BNB  100                    Enabled\n"+
+		"BTC.BTC  2000          {\"a\":1}  Bootstrap\n")
+
+	out.Reset()
+	res = []byte(`{"status":"Enabled","asset":"BNB.BNB","pool_units":12345678901234567890}`)
+	c.Assert(printTable(&out, res), IsNil)
+	c.Check(out.String(), Equals, ""+
+		"asset       BNB.BNB\n"+
+		"pool_units  12345678901234567890\n"+
+		"status      Enabled\n")
+
+	out.Reset()
+	c.Assert(printTable(&out, []byte(`["a","b"]`)), IsNil)
+	c.Check(out.String(), Equals, "a\nb\n")
+
+	out.Reset()
+	c.Assert(printTable(&out, []byte(`"pong"`)), IsNil)
+	c.Check(out.String(), Equals, "pong\n")
+
+	c.Check(printTable(&out, []byte(`{`)), NotNil)
+}
+
+func (s *QuerySuite) TestTableColumns(c *C) {
+	items := []interface{}{
+		map[string]interface{}{"b": 1, "a": 2},
+		"not an object",
+		map[string]interface{}{"c": 3, "a": 4},
+	}
+	c.Check(tableColumns(items), DeepEquals, []string{"a", "b", "c"})
+	c.Check(tableColumns([]interface{}{"a", "b"}), HasLen, 0)
+}
+
+func (s *QuerySuite) TestTableCell(c *C) {
+	c.Check(tableCell(nil), Equals, "")
+	c.Check(tableCell("BNB.BNB"), Equals, "BNB.BNB")
+	c.Check(tableCell(json.Number("12345678901234567890")), Equals, "12345678901234567890")
+	c.Check(tableCell(true), Equals, "true")
+	c.Check(tableCell([]interface{}{"a", "b"}), Equals, `["a","b"]`)
+	c.Check(tableCell(map[string]interface{}{"a": json.Number("1")}), Equals, `{"a":1}`)
+}
@@ -10,11 +10,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/spf13/cobra"
 
 	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/constants"
 
+	mem "gitlab.com/thorchain/thornode/x/thorchain/memo"
 	"gitlab.com/thorchain/thornode/x/thorchain/types"
 )
 
@@ -33,6 +35,10 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdSetIPAddress(cdc),
 		GetCmdBan(cdc),
 		GetCmdMimir(cdc),
+		GetCmdRegisterMemo(cdc),
+		GetCmdManageTHORName(cdc),
+		GetCmdDeposit(cdc),
+		GetCmdSend(cdc),
 	)...)
 
 	return thorchainTxCmd
@@ -62,6 +68,126 @@ func GetCmdMimir(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdRegisterMemo command to register a memo, inbound txs can use the returned reference as their memo
+func GetCmdRegisterMemo(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "register-memo [memo]",
+		Short: "registers a memo, inbound txs can use REF:<id> as their memo instead (costs the transaction fee)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRegisterMemo(args[0], cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdManageTHORName command to register or renew a THORName, and set its address on a chain
+func GetCmdManageTHORName(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "manage-thorname [name] [chain] [address] [rune amount]",
+		Short: "registers or renews a THORName and sets its address on the chain, the rune amount (1e8) pays for the registration",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			chain, err := common.NewChain(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid chain: %w", err)
+			}
+			addr, err := common.NewAddress(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid address: %w", err)
+			}
+			amt, err := sdk.ParseUint(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid rune amount: %w", err)
+			}
+			coin := common.NewCoin(common.RuneNative, amt)
+			msg := types.NewMsgManageTHORName(args[0], chain, addr, coin, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdDeposit command to deposit coins native to THORChain with a memo, e.g. to swap or stake native RUNE
+func GetCmdDeposit(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit [amount] [asset] [memo]",
+		Short: "deposits coins native to THORChain with a memo, the amount is in 1e8 (costs the transaction fee)",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amt, err := sdk.ParseUint(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+			asset, err := common.NewAsset(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid asset: %w", err)
+			}
+			if err := mem.ValidateMemo(args[2]); err != nil {
+				return fmt.Errorf("invalid memo: %w", err)
+			}
+			msg := types.NewMsgNativeTx(common.Coins{common.NewCoin(asset, amt)}, args[2], cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSend command to send coins native to THORChain to another THORChain address, a memo can be set with --memo
+func GetCmdSend(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "send [to address] [amount] [asset]",
+		Short: "sends coins native to THORChain to an address, the amount is in 1e8",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid to address: %w", err)
+			}
+			amt, err := sdk.ParseUint(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+			asset, err := common.NewAsset(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid asset: %w", err)
+			}
+			coin, err := common.NewCoin(asset, amt).Native()
+			if err != nil {
+				return fmt.Errorf("%s is not native to THORChain: %w", asset, err)
+			}
+			msg := bank.MsgSend{
+				FromAddress: cliCtx.GetFromAddress(),
+				ToAddress:   to,
+				Amount:      sdk.NewCoins(coin),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdBan command to ban a node accounts
 func GetCmdBan(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
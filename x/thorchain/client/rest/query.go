@@ -10,6 +10,13 @@ import (
 	"github.com/gorilla/mux"
 
 	"gitlab.com/thorchain/thornode/x/thorchain/query"
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+const (
+	// sample the pool history every 720 blocks (about an hour) by default
+	defaultPoolHistoryInterval = 720
+	maxPoolHistoryCount        = 100
 )
 
 // Ping - endpoint to check that the API is up and available
@@ -22,14 +29,9 @@ func pingHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 // Generic wrapper to generate GET handler
 func getHandlerWrapper(q query.Query, storeName string, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		heightStr, ok := r.URL.Query()["height"]
-		if ok && len(heightStr) > 0 {
-			height, err := strconv.ParseInt(heightStr[0], 10, 64)
-			if err != nil {
-				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-				return
-			}
-			cliCtx = cliCtx.WithHeight(height)
+		cliCtx, ok := withHeightFromQuery(w, r, cliCtx)
+		if !ok {
+			return
 		}
 		param := mux.Vars(r)[restURLParam]
 		text, err := r.URL.MarshalBinary()
@@ -47,3 +49,99 @@ func getHandlerWrapper(q query.Query, storeName string, cliCtx context.CLIContex
 		_, _ = w.Write(res)
 	}
 }
+
+// withHeightFromQuery returns a copy of the cli context querying the state at
+// the `height` query string param, the latest state when not set
+func withHeightFromQuery(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext) (context.CLIContext, bool) {
+	heightStr := r.URL.Query().Get("height")
+	if heightStr == "" {
+		return cliCtx, true
+	}
+	height, err := strconv.ParseInt(heightStr, 10, 64)
+	if err != nil || height < 0 {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid height: %s", heightStr))
+		return cliCtx, false
+	}
+	return cliCtx.WithHeight(height), true
+}
+
+// poolHistoryHandler returns the depth and price of a pool at every height
+// that is a multiple of the `interval` query string param, newest first, up to
+// `count` sampled heights. Heights the node has pruned are skipped, so the
+// history needs thord to run with `--pruning nothing`, or to keep every
+// `interval`th state with `--pruning-keep-every`.
+func poolHistoryHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := withHeightFromQuery(w, r, cliCtx)
+		if !ok {
+			return
+		}
+		interval, ok := getPositiveInt64FromQuery(w, r, "interval", defaultPoolHistoryInterval)
+		if !ok {
+			return
+		}
+		count, ok := getPositiveInt64FromQuery(w, r, "count", maxPoolHistoryCount)
+		if !ok {
+			return
+		}
+		if count > maxPoolHistoryCount {
+			count = maxPoolHistoryCount
+		}
+
+		path := query.QueryPool.Path(storeName, mux.Vars(r)[restURLParam])
+		res, height, err := cliCtx.QueryWithData(path, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		snapshots := make([]types.QueryPoolSnapshot, 0, count)
+		latest := res
+		for _, h := range poolHistoryHeights(height, interval, count) {
+			res = latest
+			if h != height {
+				res, _, err = cliCtx.WithHeight(h).QueryWithData(path, nil)
+				if err != nil {
+					// the node pruned the state at this height
+					continue
+				}
+			}
+			var pool types.Pool
+			if err := cliCtx.Codec.UnmarshalJSON(res, &pool); err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			snapshots = append(snapshots, types.NewQueryPoolSnapshot(h, pool))
+		}
+		if len(snapshots) == 0 {
+			rest.WriteErrorResponse(w, http.StatusNotFound, fmt.Sprintf("no pool state at the multiples of %d, the node may have pruned them", interval))
+			return
+		}
+		rest.PostProcessResponseBare(w, cliCtx, snapshots)
+	}
+}
+
+// poolHistoryHeights returns the heights sampled for the pool history, the
+// multiples of interval at or below height, newest first, at most count of
+// them
+func poolHistoryHeights(height, interval, count int64) []int64 {
+	heights := make([]int64, 0, count)
+	for h := height - height%interval; h > 0 && int64(len(heights)) < count; h -= interval {
+		heights = append(heights, h)
+	}
+	return heights
+}
+
+// getPositiveInt64FromQuery returns the given query string param, or the
+// default value when it isn't set
+func getPositiveInt64FromQuery(w http.ResponseWriter, r *http.Request, key string, defaultValue int64) (int64, bool) {
+	value := r.URL.Query().Get(key)
+	if value == "" {
+		return defaultValue, true
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n <= 0 {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid %s: %s", key, value))
+		return 0, false
+	}
+	return n, true
+}
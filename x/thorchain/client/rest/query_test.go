@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	. "gopkg.in/check.v1"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type QuerySuite struct{}
+
+var _ = Suite(&QuerySuite{})
+
+func (s *QuerySuite) TestWithHeightFromQuery(c *C) {
+	cliCtx := context.CLIContext{Height: 0}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/thorchain/pools", nil)
+	ctx, ok := withHeightFromQuery(w, r, cliCtx)
+	c.Assert(ok, Equals, true)
+	c.Check(ctx.Height, Equals, int64(0))
+
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodGet, "/thorchain/pools?height=1024", nil)
+	ctx, ok = withHeightFromQuery(w, r, cliCtx)
+	c.Assert(ok, Equals, true)
+	c.Check(ctx.Height, Equals, int64(1024))
+
+	for _, height := range []string{"-1", "abc"} {
+		w = httptest.NewRecorder()
+		r = httptest.NewRequest(http.MethodGet, "/thorchain/pools?height="+height, nil)
+		_, ok = withHeightFromQuery(w, r, cliCtx)
+		c.Check(ok, Equals, false, Commentf("%s", height))
+		c.Check(w.Code, Equals, http.StatusBadRequest, Commentf("%s", height))
+	}
+}
+
+func (s *QuerySuite) TestGetPositiveInt64FromQuery(c *C) {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/thorchain/pool/BNB.BNB/history", nil)
+	n, ok := getPositiveInt64FromQuery(w, r, "count", 100)
+	c.Assert(ok, Equals, true)
+	c.Check(n, Equals, int64(100))
+
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodGet, "/thorchain/pool/BNB.BNB/history?count=10", nil)
+	n, ok = getPositiveInt64FromQuery(w, r, "count", 100)
+	c.Assert(ok, Equals, true)
+	c.Check(n, Equals, int64(10))
+
+	for _, count := range []string{"0", "-10", "abc"} {
+		w = httptest.NewRecorder()
+		r = httptest.NewRequest(http.MethodGet, "/thorchain/pool/BNB.BNB/history?count="+count, nil)
+		_, ok = getPositiveInt64FromQuery(w, r, "count", 100)
+		c.Check(ok, Equals, false, Commentf("%s", count))
+		c.Check(w.Code, Equals, http.StatusBadRequest, Commentf("%s", count))
+	}
+}
+
+func (s *QuerySuite) TestPoolHistoryHeights(c *C) {
+	c.Check(poolHistoryHeights(2000, 720, 100), DeepEquals, []int64{1440, 720})
+	c.Check(poolHistoryHeights(1440, 720, 100), DeepEquals, []int64{1440, 720})
+	c.Check(poolHistoryHeights(10000, 1000, 3), DeepEquals, []int64{10000, 9000, 8000})
+	c.Check(poolHistoryHeights(500, 720, 100), HasLen, 0)
+}
@@ -45,6 +45,11 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		}
 	}
 
+	r.Handle(
+		fmt.Sprintf("/%s/pool/{%s}/history", storeName, restURLParam),
+		tollbooth.LimitFuncHandler(lmt, poolHistoryHandler(cliCtx, storeName)),
+	).Methods(http.MethodGet, http.MethodOptions)
+
 	// Get unsigned json for emitting a transaction. Validators only.
 	r.HandleFunc(
 		fmt.Sprintf("/%s/txs", storeName),
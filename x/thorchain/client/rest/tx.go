@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -10,6 +11,7 @@ import (
 	"gitlab.com/thorchain/tss/go-tss/blame"
 
 	"gitlab.com/thorchain/thornode/common"
+	mem "gitlab.com/thorchain/thornode/x/thorchain/memo"
 	"gitlab.com/thorchain/thornode/x/thorchain/types"
 )
 
@@ -38,6 +40,10 @@ func newNativeTxHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		if err := mem.ValidateMemo(req.BaseReq.Memo); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid memo: %s", err))
+			return
+		}
 		msg := types.NewMsgNativeTx(req.Coins, req.BaseReq.Memo, addr)
 		err = msg.ValidateBasic()
 		if err != nil {
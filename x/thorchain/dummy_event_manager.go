@@ -74,6 +74,10 @@ func (m *DummyEventMgr) EmitOutboundEvent(ctx sdk.Context, outbound EventOutboun
 	return nil
 }
 
+func (m *DummyEventMgr) EmitJailEvent(ctx sdk.Context, keeper Keeper, jailEvt EventJail) error {
+	return nil
+}
+
 type DummyVersionedEventMgr struct{}
 
 func NewDummyVersionedEventMgr() *DummyVersionedEventMgr {
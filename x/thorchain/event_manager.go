@@ -26,6 +26,7 @@ type EventManager interface {
 	EmitFeeEvent(ctx sdk.Context, keeper Keeper, feeEvent EventFee) error
 	EmitSlashEvent(ctx sdk.Context, keeper Keeper, slashEvt EventSlash) error
 	EmitOutboundEvent(ctx sdk.Context, outbound EventOutbound) error
+	EmitJailEvent(ctx sdk.Context, keeper Keeper, jailEvt EventJail) error
 }
 
 // EventMgr implement EventManager interface
@@ -340,3 +341,27 @@ func (m *EventMgr) EmitOutboundEvent(ctx sdk.Context, outbound EventOutbound) er
 	ctx.EventManager().EmitEvents(events)
 	return nil
 }
+
+// EmitJailEvent save jail event to local key value store, and add it to event manager
+func (m *EventMgr) EmitJailEvent(ctx sdk.Context, keeper Keeper, jailEvt EventJail) error {
+	buf, err := json.Marshal(jailEvt)
+	if err != nil {
+		return fmt.Errorf("fail to marshal jail event to buf: %w", err)
+	}
+	event := NewEvent(
+		jailEvt.Type(),
+		ctx.BlockHeight(),
+		common.Tx{ID: common.BlankTxID},
+		buf,
+		EventSuccess,
+	)
+	if err := keeper.UpsertEvent(ctx, event); err != nil {
+		return fmt.Errorf("fail to save event: %w", err)
+	}
+	events, err := jailEvt.Events()
+	if err != nil {
+		return fmt.Errorf("fail to get events: %w", err)
+	}
+	ctx.EventManager().EmitEvents(events)
+	return nil
+}
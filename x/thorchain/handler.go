@@ -36,6 +36,7 @@ const (
 	CodeStakeInvalidPoolAsset  sdk.CodeType = 124
 	CodeStakeRUNEOverLimit     sdk.CodeType = 125
 	CodeStakeRUNEMoreThanBond  sdk.CodeType = 126
+	CodeStakePendingExpired    sdk.CodeType = 127
 
 	CodeUnstakeFailValidation sdk.CodeType = 130
 	CodeFailAddOutboundTx     sdk.CodeType = 131
@@ -103,10 +104,13 @@ func getHandlerMapping(keeper Keeper,
 	m[MsgNativeTx{}.Type()] = NewNativeTxHandler(keeper, versionedObserverManager, versionedTxOutStore, validatorMgr, versionedVaultManager, versionedGasMgr, versionedEventManager)
 	m[MsgObservedTxIn{}.Type()] = NewObservedTxInHandler(keeper, versionedObserverManager, versionedTxOutStore, validatorMgr, versionedVaultManager, versionedGasMgr, versionedEventManager)
 	m[MsgObservedTxOut{}.Type()] = NewObservedTxOutHandler(keeper, versionedObserverManager, versionedTxOutStore, validatorMgr, versionedVaultManager, versionedGasMgr, versionedEventManager)
-	m[MsgTssKeysignFail{}.Type()] = NewTssKeysignHandler(keeper)
+	m[MsgTssKeysignFail{}.Type()] = NewTssKeysignHandler(keeper, versionedEventManager)
 	m[MsgErrataTx{}.Type()] = NewErrataTxHandler(keeper, versionedEventManager)
 	m[MsgSend{}.Type()] = NewSendHandler(keeper)
 	m[MsgMimir{}.Type()] = NewMimirHandler(keeper)
+	m[MsgNetworkFee{}.Type()] = NewNetworkFeeHandler(keeper)
+	m[MsgRegisterMemo{}.Type()] = NewRegisterMemoHandler(keeper)
+	m[MsgManageTHORName{}.Type()] = NewManageTHORNameHandler(keeper)
 	return m
 }
 
@@ -150,6 +154,7 @@ func getInternalHandlerMapping(keeper Keeper,
 	m[MsgReserveContributor{}.Type()] = NewReserveContributorHandler(keeper, versionedEventManager)
 	m[MsgBond{}.Type()] = NewBondHandler(keeper, versionedEventManager)
 	m[MsgLeave{}.Type()] = NewLeaveHandler(keeper, validatorMgr, versionedTxOutStore, versionedEventManager)
+	m[MsgUnbond{}.Type()] = NewUnbondHandler(keeper, versionedTxOutStore, versionedEventManager)
 	m[MsgAdd{}.Type()] = NewAddHandler(keeper, versionedEventManager)
 	m[MsgSetUnStake{}.Type()] = NewUnstakeHandler(keeper, versionedTxOutStore, versionedEventManager)
 	m[MsgSetStakeData{}.Type()] = NewStakeHandler(keeper, versionedEventManager)
@@ -157,6 +162,7 @@ func getInternalHandlerMapping(keeper Keeper,
 	m[MsgMigrate{}.Type()] = NewMigrateHandler(keeper, versionedEventManager)
 	m[MsgRagnarok{}.Type()] = NewRagnarokHandler(keeper, versionedEventManager)
 	m[MsgSwitch{}.Type()] = NewSwitchHandler(keeper, versionedTxOutStore)
+	m[MsgNoOp{}.Type()] = NewNoOpHandler(keeper)
 	return m
 }
 
@@ -194,7 +200,7 @@ func processOneTxIn(ctx sdk.Context, keeper Keeper, tx ObservedTx, signer sdk.Ac
 		return nil, sdk.ErrUnknownRequest("no coin found")
 	}
 
-	memo, err := ParseMemo(tx.Tx.Memo)
+	memo, err := ParseMemoWithTHORNames(ctx, keeper, tx.Tx.Memo)
 	if err != nil {
 		ctx.Logger().Error("fail to parse memo", "error", err)
 		return nil, sdk.NewError(DefaultCodespace, CodeInvalidMemo, err.Error())
@@ -204,7 +210,7 @@ func processOneTxIn(ctx sdk.Context, keeper Keeper, tx ObservedTx, signer sdk.Ac
 	// interpret the memo and initialize a corresponding msg event
 	switch m := memo.(type) {
 	case StakeMemo:
-		newMsg, err = getMsgStakeFromMemo(ctx, m, tx, signer)
+		newMsg, err = getMsgStakeFromMemo(ctx, keeper, m, tx, signer)
 		if err != nil {
 			return nil, sdk.NewError(DefaultCodespace, CodeInvalidMemo, "invalid stake memo:%s", err.Error())
 		}
@@ -256,6 +262,8 @@ func processOneTxIn(ctx sdk.Context, keeper Keeper, tx ObservedTx, signer sdk.Ac
 		}
 	case LeaveMemo:
 		newMsg = NewMsgLeave(tx.Tx, signer)
+	case UnbondMemo:
+		newMsg = NewMsgUnbond(tx.Tx, m.NodeAddress, m.Amount, tx.Tx.FromAddress, m.BondProviderAddress, signer)
 	case YggdrasilFundMemo:
 		newMsg = NewMsgYggdrasil(tx.Tx, tx.ObservedPubKey, m.GetBlockHeight(), true, tx.Tx.Coins, signer)
 	case YggdrasilReturnMemo:
@@ -265,6 +273,11 @@ func processOneTxIn(ctx sdk.Context, keeper Keeper, tx ObservedTx, signer sdk.Ac
 		newMsg = NewMsgReserveContributor(tx.Tx, res, signer)
 	case SwitchMemo:
 		newMsg = NewMsgSwitch(tx.Tx, memo.GetDestination(), signer)
+	case ConsolidateMemo:
+		newMsg, err = getMsgConsolidateFromMemo(tx, signer)
+		if err != nil {
+			return nil, sdk.NewError(DefaultCodespace, CodeInvalidMemo, "invalid consolidate memo:%s", err.Error())
+		}
 
 	default:
 		return nil, sdk.NewError(DefaultCodespace, CodeInvalidMemo, "invalid memo")
@@ -285,6 +298,19 @@ func getMsgNoOpFromMemo(tx ObservedTx, signer sdk.AccAddress) (sdk.Msg, error) {
 	return NewMsgNoOp(tx, signer), nil
 }
 
+// getMsgConsolidateFromMemo only accept a consolidate tx sent by the vault to itself, a vault merging its own utxos,
+// the funds stay in the vault. Anyone else using the memo get refunded
+func getMsgConsolidateFromMemo(tx ObservedTx, signer sdk.AccAddress) (sdk.Msg, error) {
+	vaultAddr, err := tx.ObservedPubKey.GetAddress(tx.Tx.Chain)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get vault address: %w", err)
+	}
+	if !tx.Tx.FromAddress.Equals(vaultAddr) {
+		return nil, errors.New("consolidate tx not sent by the vault")
+	}
+	return NewMsgNoOp(tx, signer), nil
+}
+
 func getMsgSwapFromMemo(memo SwapMemo, tx ObservedTx, signer sdk.AccAddress) (sdk.Msg, error) {
 	if len(tx.Tx.Coins) > 1 {
 		return nil, errors.New("not expecting multiple coins in a swap")
@@ -299,7 +325,7 @@ func getMsgSwapFromMemo(memo SwapMemo, tx ObservedTx, signer sdk.AccAddress) (sd
 	}
 
 	// Looks like at the moment THORNode can only process ont ty
-	return NewMsgSwap(tx.Tx, memo.GetAsset(), memo.Destination, memo.SlipLimit, signer), nil
+	return NewMsgSwap(tx.Tx, memo.GetAsset(), memo.Destination, memo.SlipLimit, memo.AffiliateAddress, memo.AffiliateBasisPoints, signer), nil
 }
 
 func getMsgUnstakeFromMemo(memo UnstakeMemo, tx ObservedTx, signer sdk.AccAddress) (sdk.Msg, error) {
@@ -310,7 +336,7 @@ func getMsgUnstakeFromMemo(memo UnstakeMemo, tx ObservedTx, signer sdk.AccAddres
 	return NewMsgSetUnStake(tx.Tx, tx.Tx.FromAddress, withdrawAmount, memo.GetAsset(), signer), nil
 }
 
-func getMsgStakeFromMemo(ctx sdk.Context, memo StakeMemo, tx ObservedTx, signer sdk.AccAddress) (sdk.Msg, error) {
+func getMsgStakeFromMemo(ctx sdk.Context, keeper Keeper, memo StakeMemo, tx ObservedTx, signer sdk.AccAddress) (sdk.Msg, error) {
 	// when staker stake to a pool ,usually it will be two coins, RUNE and the asset of the pool.
 	// if it is multi-chain , like NOT Binance chain , it is using two asymmetric staking
 	if len(tx.Tx.Coins) > 2 {
@@ -347,14 +373,29 @@ func getMsgStakeFromMemo(ctx sdk.Context, memo StakeMemo, tx ObservedTx, signer
 		return nil, fmt.Errorf("did not find %s ", asset)
 	}
 
+	// the address in the memo is the RUNE address when the tx is on the asset chain, and the asset address otherwise
+	memoAddr := memo.GetDestination()
+	if !memoAddr.IsEmpty() {
+		addrChain := asset.Chain
+		if !tx.Tx.FromAddress.IsChain(common.RuneAsset().Chain) {
+			addrChain = common.RuneAsset().Chain
+		}
+		var err error
+		memoAddr, err = resolveTHORName(ctx, keeper, memoAddr.String(), addrChain)
+		if err != nil {
+			return nil, err
+		}
+	}
 	runeAddr := tx.Tx.FromAddress
-	assetAddr := memo.GetDestination()
+	assetAddr := memoAddr
 	// this is to cover multi-chain scenario, for example BTC , staker who would like to stake in BTC pool,  will have to complete
 	// the stake operation by sending in two asymmetric stake tx, one tx on BTC chain with memo stake:BTC:<RUNE address> ,
-	// and another one on Binance chain with stake:BTC , with only RUNE as the coin
-	// Thorchain will use the <RUNE address> to match these two together , and consider it as one stake.
+	// and another one on Binance chain with stake:BTC:<BTC address> , with only RUNE as the coin
+	// Thorchain will use the <RUNE address> and the <BTC address> to match these two together , and consider it as one stake.
+	// When the RUNE tx comes with stake:BTC only, its asset address is the RUNE address, and it is matched with the BTC
+	// tx on the <RUNE address> alone
 	if !runeAddr.IsChain(common.RuneAsset().Chain) {
-		runeAddr = memo.GetDestination()
+		runeAddr = memoAddr
 		assetAddr = tx.Tx.FromAddress
 	} else {
 		// if it is on BNB chain , while the asset addr is empty, then the asset addr is runeAddr
@@ -427,5 +468,5 @@ func getMsgBondFromMemo(memo BondMemo, tx ObservedTx, signer sdk.AccAddress) (sd
 	if runeAmount.IsZero() {
 		return nil, errors.New("RUNE amount is 0")
 	}
-	return NewMsgBond(tx.Tx, memo.GetAccAddress(), runeAmount, tx.Tx.FromAddress, signer), nil
+	return NewMsgBond(tx.Tx, memo.GetAccAddress(), runeAmount, tx.Tx.FromAddress, memo.BondProviderAddress, memo.OperatorFee, signer), nil
 }
@@ -112,7 +112,7 @@ func (h BanHandler) handleV1(ctx sdk.Context, msg MsgBan, constAccessor constant
 			minBond = constAccessor.GetInt64Value(constants.MinimumBondInRune)
 		}
 		slashAmount := sdk.NewUint(uint64(minBond)).QuoUint64(1000)
-		banner.Bond = common.SafeSub(banner.Bond, slashAmount)
+		banner.SubBond(slashAmount)
 
 		if common.RuneAsset().Chain.Equals(common.THORChain) {
 			coin := common.NewCoin(common.RuneNative, slashAmount)
@@ -50,8 +50,27 @@ func (h BondHandler) validateV1(ctx sdk.Context, version semver.Version, msg Msg
 		return sdk.ErrInternal(fmt.Sprintf("fail to get node account(%s): %s", msg.NodeAddress, err))
 	}
 
+	isOperator := nodeAccount.Status == NodeUnknown || nodeAccount.BondAddress.Equals(msg.BondAddress)
+	if !isOperator {
+		if !nodeAccount.BondProviders.Has(msg.BondAddress) {
+			return sdk.ErrUnauthorized(fmt.Sprintf("%s is not a bond provider of node(%s)", msg.BondAddress, msg.NodeAddress))
+		}
+		if !msg.BondProviderAddress.IsEmpty() || msg.OperatorFee >= 0 {
+			return sdk.ErrUnauthorized("only node operator can whitelist bond providers or set operator fee")
+		}
+	}
+	// bond providers are paid with the operator fee the node was churned in
+	// with, it can only change while the node is not active
+	if isOperator && nodeAccount.Status == NodeActive && msg.OperatorFee >= 0 && msg.OperatorFee != nodeAccount.OperatorFee {
+		return sdk.ErrUnauthorized("operator fee can't be changed while the node is active")
+	}
+
 	bond := msg.Bond.Add(nodeAccount.Bond)
-	if (bond).LT(minValidatorBond) {
+	// the first bond of the node operator always need to reach the minimum
+	// bond. Once bond providers pool their bond together, their bond doesn't
+	// need to on its own
+	isFirstBond := nodeAccount.Status == NodeUnknown
+	if (isFirstBond || len(nodeAccount.BondProviders) == 0) && bond.LT(minValidatorBond) {
 		return sdk.ErrUnknownRequest(fmt.Sprintf("not enough rune to be whitelisted , minimum validator bond (%s) , bond(%s)", minValidatorBond.String(), bond))
 	}
 
@@ -122,11 +141,26 @@ func (h BondHandler) handle(ctx sdk.Context, msg MsgBond, version semver.Version
 			))
 	}
 
-	nodeAccount.Bond = nodeAccount.Bond.Add(msg.Bond)
+	isOperator := nodeAccount.BondAddress.Equals(msg.BondAddress)
+	if isOperator {
+		nodeAccount.AddBond(msg.Bond)
+		if !msg.BondProviderAddress.IsEmpty() {
+			nodeAccount.AddBondProvider(msg.BondProviderAddress)
+		}
+		if msg.OperatorFee >= 0 {
+			nodeAccount.OperatorFee = msg.OperatorFee
+		}
+	} else if !nodeAccount.AddProviderBond(msg.BondAddress, msg.Bond) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not a bond provider of node(%s)", msg.BondAddress, msg.NodeAddress))
+	}
 
 	if err := h.keeper.SetNodeAccount(ctx, nodeAccount); err != nil {
 		return sdk.ErrInternal(fmt.Errorf("fail to save node account(%s): %w", nodeAccount, err).Error())
 	}
+	// gas asset is only minted for the node operator, bond providers can bond any amount
+	if !isOperator {
+		return nil
+	}
 	return h.mintGasAsset(ctx, msg, constAccessor)
 }
 
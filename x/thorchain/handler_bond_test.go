@@ -59,7 +59,7 @@ func (HandlerBondSuite) TestBondHandler_Run(c *C) {
 		common.Gas{},
 		"apply",
 	)
-	msg := NewMsgBond(txIn, GetRandomNodeAccount(NodeStandby).NodeAddress, sdk.NewUint(uint64(minimumBondInRune)), GetRandomBNBAddress(), activeNodeAccount.NodeAddress)
+	msg := NewMsgBond(txIn, GetRandomNodeAccount(NodeStandby).NodeAddress, sdk.NewUint(uint64(minimumBondInRune)), GetRandomBNBAddress(), common.NoAddress, -1, activeNodeAccount.NodeAddress)
 	result := handler.Run(ctx, msg, ver, constAccessor)
 	c.Assert(result.IsOK(), Equals, true)
 
@@ -71,11 +71,11 @@ func (HandlerBondSuite) TestBondHandler_Run(c *C) {
 
 	// simulate fail to get node account
 	ver = constants.SWVersion
-	msg = NewMsgBond(txIn, k.failGetNodeAccount.NodeAddress, sdk.NewUint(uint64(minimumBondInRune)), GetRandomBNBAddress(), activeNodeAccount.NodeAddress)
+	msg = NewMsgBond(txIn, k.failGetNodeAccount.NodeAddress, sdk.NewUint(uint64(minimumBondInRune)), GetRandomBNBAddress(), common.NoAddress, -1, activeNodeAccount.NodeAddress)
 	result = handler.Run(ctx, msg, ver, constAccessor)
 	c.Assert(result.Code, Equals, sdk.CodeInternal)
 
-	msg = NewMsgBond(txIn, k.notEmptyNodeAccount.NodeAddress, sdk.NewUint(uint64(minimumBondInRune)), GetRandomBNBAddress(), activeNodeAccount.NodeAddress)
+	msg = NewMsgBond(txIn, k.notEmptyNodeAccount.NodeAddress, sdk.NewUint(uint64(minimumBondInRune)), k.notEmptyNodeAccount.BondAddress, common.NoAddress, -1, activeNodeAccount.NodeAddress)
 	result = handler.Run(ctx, msg, ver, constAccessor)
 	c.Assert(result.Code, Equals, sdk.CodeInternal)
 }
@@ -107,37 +107,42 @@ func (HandlerBondSuite) TestBondHandlerFailValidation(c *C) {
 	}{
 		{
 			name:         "empty node address",
-			msg:          NewMsgBond(txIn, sdk.AccAddress{}, sdk.NewUint(uint64(minimumBondInRune)), GetRandomBNBAddress(), activeNodeAccount.NodeAddress),
+			msg:          NewMsgBond(txIn, sdk.AccAddress{}, sdk.NewUint(uint64(minimumBondInRune)), GetRandomBNBAddress(), common.NoAddress, -1, activeNodeAccount.NodeAddress),
 			expectedCode: sdk.CodeUnknownRequest,
 		},
 		{
 			name:         "zero bond",
-			msg:          NewMsgBond(txIn, GetRandomNodeAccount(NodeStandby).NodeAddress, sdk.ZeroUint(), GetRandomBNBAddress(), activeNodeAccount.NodeAddress),
+			msg:          NewMsgBond(txIn, GetRandomNodeAccount(NodeStandby).NodeAddress, sdk.ZeroUint(), GetRandomBNBAddress(), common.NoAddress, -1, activeNodeAccount.NodeAddress),
 			expectedCode: sdk.CodeUnknownRequest,
 		},
 		{
 			name:         "empty bond address",
-			msg:          NewMsgBond(txIn, GetRandomNodeAccount(NodeStandby).NodeAddress, sdk.NewUint(uint64(minimumBondInRune)), common.Address(""), activeNodeAccount.NodeAddress),
+			msg:          NewMsgBond(txIn, GetRandomNodeAccount(NodeStandby).NodeAddress, sdk.NewUint(uint64(minimumBondInRune)), common.Address(""), common.NoAddress, -1, activeNodeAccount.NodeAddress),
 			expectedCode: sdk.CodeUnknownRequest,
 		},
 		{
 			name:         "empty request hash",
-			msg:          NewMsgBond(txInNoTxID, GetRandomNodeAccount(NodeStandby).NodeAddress, sdk.NewUint(uint64(minimumBondInRune)), GetRandomBNBAddress(), activeNodeAccount.NodeAddress),
+			msg:          NewMsgBond(txInNoTxID, GetRandomNodeAccount(NodeStandby).NodeAddress, sdk.NewUint(uint64(minimumBondInRune)), GetRandomBNBAddress(), common.NoAddress, -1, activeNodeAccount.NodeAddress),
 			expectedCode: sdk.CodeUnknownRequest,
 		},
 		{
 			name:         "empty signer",
-			msg:          NewMsgBond(txIn, GetRandomNodeAccount(NodeStandby).NodeAddress, sdk.NewUint(uint64(minimumBondInRune)), GetRandomBNBAddress(), sdk.AccAddress{}),
+			msg:          NewMsgBond(txIn, GetRandomNodeAccount(NodeStandby).NodeAddress, sdk.NewUint(uint64(minimumBondInRune)), GetRandomBNBAddress(), common.NoAddress, -1, sdk.AccAddress{}),
 			expectedCode: sdk.CodeInvalidAddress,
 		},
 		{
 			name:         "msg not signed by active account",
-			msg:          NewMsgBond(txIn, GetRandomNodeAccount(NodeStandby).NodeAddress, sdk.NewUint(uint64(minimumBondInRune)), GetRandomBNBAddress(), GetRandomNodeAccount(NodeStandby).NodeAddress),
+			msg:          NewMsgBond(txIn, GetRandomNodeAccount(NodeStandby).NodeAddress, sdk.NewUint(uint64(minimumBondInRune)), GetRandomBNBAddress(), common.NoAddress, -1, GetRandomNodeAccount(NodeStandby).NodeAddress),
+			expectedCode: sdk.CodeUnauthorized,
+		},
+		{
+			name:         "not a bond provider",
+			msg:          NewMsgBond(txIn, activeNodeAccount.NodeAddress, sdk.NewUint(uint64(minimumBondInRune)), GetRandomBNBAddress(), common.NoAddress, -1, activeNodeAccount.NodeAddress),
 			expectedCode: sdk.CodeUnauthorized,
 		},
 		{
 			name:         "not enough rune",
-			msg:          NewMsgBond(txIn, GetRandomNodeAccount(NodeStandby).NodeAddress, sdk.NewUint(uint64(minimumBondInRune-100)), GetRandomBNBAddress(), activeNodeAccount.NodeAddress),
+			msg:          NewMsgBond(txIn, GetRandomNodeAccount(NodeStandby).NodeAddress, sdk.NewUint(uint64(minimumBondInRune-100)), GetRandomBNBAddress(), common.NoAddress, -1, activeNodeAccount.NodeAddress),
 			expectedCode: sdk.CodeUnknownRequest,
 		},
 	}
@@ -147,3 +152,78 @@ func (HandlerBondSuite) TestBondHandlerFailValidation(c *C) {
 		c.Assert(result.Code, Equals, item.expectedCode)
 	}
 }
+
+func (HandlerBondSuite) TestBondProviders(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	activeNodeAccount := GetRandomNodeAccount(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, activeNodeAccount), IsNil)
+	handler := NewBondHandler(k, NewVersionedEventMgr())
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	minimumBondInRune := constAccessor.GetInt64Value(constants.MinimumBondInRune)
+
+	nodeAddr := GetRandomBech32Addr()
+	operatorAddr := GetRandomBNBAddress()
+	providerAddr := GetRandomBNBAddress()
+	bond := sdk.NewUint(uint64(minimumBondInRune))
+	txIn := GetRandomTx()
+
+	// the first bond need to reach the minimum bond, even when whitelisting a bond provider
+	msg := NewMsgBond(txIn, nodeAddr, common.SafeSub(bond, sdk.OneUint()), operatorAddr, providerAddr, 2000, activeNodeAccount.NodeAddress)
+	result := handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnknownRequest)
+
+	// operator whitelist a bond provider, and set the operator fee to 20%
+	msg = NewMsgBond(txIn, nodeAddr, bond, operatorAddr, providerAddr, 2000, activeNodeAccount.NodeAddress)
+	result = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.IsOK(), Equals, true, Commentf("%s", result.Log))
+	na, err := k.GetNodeAccount(ctx, nodeAddr)
+	c.Assert(err, IsNil)
+	c.Assert(na.BondProviders, HasLen, 1)
+	c.Check(na.OperatorFee, Equals, int64(2000))
+	c.Check(na.OperatorBond().Equal(bond), Equals, true)
+
+	// operator can't change the operator fee while the node is active
+	na.Status = NodeActive
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	msg = NewMsgBond(txIn, nodeAddr, bond, operatorAddr, common.NoAddress, 1000, activeNodeAccount.NodeAddress)
+	result = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnauthorized)
+	na, err = k.GetNodeAccount(ctx, nodeAddr)
+	c.Assert(err, IsNil)
+	c.Check(na.OperatorFee, Equals, int64(2000))
+	c.Check(na.Bond.Equal(bond), Equals, true)
+	na.Status = NodeStandby
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+
+	// bond provider can't change the operator fee
+	msg = NewMsgBond(txIn, nodeAddr, bond, providerAddr, common.NoAddress, 0, activeNodeAccount.NodeAddress)
+	result = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnauthorized)
+
+	// bond provider add bond
+	msg = NewMsgBond(txIn, nodeAddr, bond, providerAddr, common.NoAddress, -1, activeNodeAccount.NodeAddress)
+	result = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.IsOK(), Equals, true, Commentf("%s", result.Log))
+	na, err = k.GetNodeAccount(ctx, nodeAddr)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(bond.MulUint64(2)), Equals, true)
+	c.Check(na.BondProviders.Get(providerAddr).Bond.Equal(bond), Equals, true)
+	c.Check(na.OperatorBond().Equal(bond), Equals, true)
+
+	// rewards are split pro-rata, bond provider pays the operator fee
+	na.AddBondReward(sdk.NewUint(100 * common.One))
+	c.Check(na.BondProviders.Get(providerAddr).Bond.Equal(bond.Add(sdk.NewUint(40*common.One))), Equals, true)
+	c.Check(na.OperatorBond().Equal(bond.Add(sdk.NewUint(60*common.One))), Equals, true)
+
+	// slash reduce everyone's bond pro-rata
+	na.SubBond(na.Bond.QuoUint64(2))
+	c.Check(na.BondProviders.Get(providerAddr).Bond.Equal(bond.Add(sdk.NewUint(40*common.One)).QuoUint64(2)), Equals, true)
+
+	refunds := getBondRefunds(na, operatorAddr, na.Bond)
+	c.Assert(refunds, HasLen, 2)
+	c.Check(refunds[0].Address.Equals(operatorAddr), Equals, true)
+	c.Check(refunds[0].Amount.Equal(na.OperatorBond()), Equals, true)
+	c.Check(refunds[1].Address.Equals(providerAddr), Equals, true)
+	c.Check(refunds[1].Amount.Equal(na.BondProviders.Get(providerAddr).Bond), Equals, true)
+}
@@ -119,7 +119,7 @@ func (h ErrataTxHandler) handleV1(ctx sdk.Context, msg MsgErrataTx, version semv
 		}
 	}
 
-	memo, _ := ParseMemo(tx.Memo)
+	memo, _ := ParseMemoWithTHORNames(ctx, h.keeper, tx.Memo)
 	if !memo.IsType(TxSwap) && !memo.IsType(TxStake) {
 		// must be a swap transaction
 		return sdk.Result{
@@ -0,0 +1,141 @@
+package thorchain
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+// ManageTHORNameHandler is to handle MsgManageTHORName, it registers, renews and sets the aliases of THORNames
+type ManageTHORNameHandler struct {
+	keeper Keeper
+}
+
+// NewManageTHORNameHandler create a new instance of ManageTHORNameHandler
+func NewManageTHORNameHandler(keeper Keeper) ManageTHORNameHandler {
+	return ManageTHORNameHandler{
+		keeper: keeper,
+	}
+}
+
+// Run is the main entry point of ManageTHORNameHandler
+func (h ManageTHORNameHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	msg, ok := m.(MsgManageTHORName)
+	if !ok {
+		return errInvalidMessage.Result()
+	}
+	if err := h.validate(ctx, msg, version, constAccessor); err != nil {
+		ctx.Logger().Error("msg manage thorname failed validation", "error", err)
+		return err.Result()
+	}
+	return h.handle(ctx, msg, version, constAccessor)
+}
+
+func (h ManageTHORNameHandler) validate(ctx sdk.Context, msg MsgManageTHORName, version semver.Version, constAccessor constants.ConstantValues) sdk.Error {
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.validateV1(ctx, msg, constAccessor)
+	}
+	return errBadVersion
+}
+
+func (h ManageTHORNameHandler) validateV1(ctx sdk.Context, msg MsgManageTHORName, constAccessor constants.ConstantValues) sdk.Error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	tn, err := h.keeper.GetTHORName(ctx, msg.Name)
+	if err != nil {
+		ctx.Logger().Error("fail to get thorname", "error", err)
+		return sdk.ErrInternal("fail to get thorname")
+	}
+	if !tn.IsEmpty() && !tn.IsExpired(ctx.BlockHeight()) {
+		// only the owner can renew or change a registered THORName
+		if !tn.Owner.Equals(msg.Signer) {
+			return sdk.ErrUnauthorized(fmt.Sprintf("%s is not the owner of THORName %s", msg.Signer, tn.Name))
+		}
+		return nil
+	}
+	// registering a new THORName, the fee needs to cover the registration and at least one block
+	registerFee := sdk.NewUint(uint64(constAccessor.GetInt64Value(constants.TNSRegisterFee)))
+	feePerBlock := sdk.NewUint(uint64(constAccessor.GetInt64Value(constants.TNSFeePerBlock)))
+	if msg.Coin.Amount.LT(registerFee.Add(feePerBlock)) {
+		return sdk.ErrInsufficientCoins(fmt.Sprintf("registering a THORName costs at least %s", registerFee.Add(feePerBlock)))
+	}
+	return nil
+}
+
+func (h ManageTHORNameHandler) handle(ctx sdk.Context, msg MsgManageTHORName, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	ctx.Logger().Info("receive MsgManageTHORName", "name", msg.Name, "chain", msg.Chain, "address", msg.Address, "coin", msg.Coin)
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.handleV1(ctx, msg, constAccessor)
+	}
+	ctx.Logger().Error(errInvalidVersion.Error())
+	return errBadVersion.Result()
+}
+
+func (h ManageTHORNameHandler) handleV1(ctx sdk.Context, msg MsgManageTHORName, constAccessor constants.ConstantValues) sdk.Result {
+	transactionFee := constAccessor.GetInt64Value(constants.TransactionFee)
+	gasFee, err := common.NewCoin(common.RuneNative, sdk.NewUint(uint64(transactionFee))).Native()
+	if err != nil {
+		ctx.Logger().Error("fail to get gas fee", "err", err)
+		return sdk.ErrInternal("fail to get gas fee").Result()
+	}
+	totalCoins := sdk.NewCoins(gasFee)
+	if !msg.Coin.Amount.IsZero() {
+		coin, err := msg.Coin.Native()
+		if err != nil {
+			ctx.Logger().Error("fail to get native coin", "err", err)
+			return sdk.ErrInternal("fail to get native coin").Result()
+		}
+		totalCoins = totalCoins.Add(sdk.NewCoins(coin))
+	}
+	if !h.keeper.CoinKeeper().HasCoins(ctx, msg.Signer, totalCoins) {
+		return sdk.ErrInsufficientCoins("insufficient funds").Result()
+	}
+
+	tn, err := h.keeper.GetTHORName(ctx, msg.Name)
+	if err != nil {
+		ctx.Logger().Error("fail to get thorname", "error", err)
+		return sdk.ErrInternal("fail to get thorname").Result()
+	}
+	registerFee := sdk.NewUint(uint64(constAccessor.GetInt64Value(constants.TNSRegisterFee)))
+	feePerBlock := sdk.NewUint(uint64(constAccessor.GetInt64Value(constants.TNSFeePerBlock)))
+	paid := msg.Coin.Amount
+	if tn.IsEmpty() || tn.IsExpired(ctx.BlockHeight()) {
+		// an expired THORName is up for grabs, the aliases of the previous owner are dropped
+		tn = NewTHORName(msg.Name, ctx.BlockHeight(), msg.Signer)
+		paid = common.SafeSub(paid, registerFee)
+	}
+	tn.ExpireBlockHeight += int64(paid.Quo(feePerBlock).Uint64())
+	if !msg.Address.IsEmpty() {
+		tn.SetAlias(msg.Chain, msg.Address)
+	}
+	if err := h.keeper.SetTHORName(ctx, tn); err != nil {
+		ctx.Logger().Error("fail to save thorname", "error", err)
+		return sdk.ErrInternal("fail to save thorname").Result()
+	}
+
+	// the registration fee goes to the reserve together with the gas
+	if sdkErr := h.keeper.Supply().SendCoinsFromAccountToModule(ctx, msg.Signer, ReserveName, totalCoins); sdkErr != nil {
+		ctx.Logger().Error("unable to send fee to reserve", "error", sdkErr)
+		return sdkErr.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("thorname",
+			sdk.NewAttribute("name", tn.Name),
+			sdk.NewAttribute("chain", msg.Chain.String()),
+			sdk.NewAttribute("address", msg.Address.String()),
+			sdk.NewAttribute("owner", tn.Owner.String()),
+			sdk.NewAttribute("expire_block_height", strconv.FormatInt(tn.ExpireBlockHeight, 10))),
+	)
+	return sdk.Result{
+		Events:    ctx.EventManager().Events(),
+		Code:      sdk.CodeOK,
+		Codespace: DefaultCodespace,
+	}
+}
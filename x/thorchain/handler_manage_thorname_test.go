@@ -0,0 +1,113 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type HandlerManageTHORNameSuite struct{}
+
+var _ = Suite(&HandlerManageTHORNameSuite{})
+
+func (s *HandlerManageTHORNameSuite) TestValidate(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	handler := NewManageTHORNameHandler(k)
+	registerFee := constAccessor.GetInt64Value(constants.TNSRegisterFee)
+	feePerBlock := constAccessor.GetInt64Value(constants.TNSFeePerBlock)
+
+	owner := GetRandomBech32Addr()
+	coin := common.NewCoin(common.RuneNative, sdk.NewUint(uint64(registerFee+feePerBlock)))
+	msg := NewMsgManageTHORName("bob", common.BNBChain, GetRandomBNBAddress(), coin, owner)
+	c.Assert(handler.validate(ctx, msg, constants.SWVersion, constAccessor), IsNil)
+
+	// invalid version
+	c.Assert(handler.validate(ctx, msg, semver.Version{}, constAccessor), Equals, errBadVersion)
+
+	// invalid msg
+	c.Assert(handler.validate(ctx, MsgManageTHORName{}, constants.SWVersion, constAccessor), NotNil)
+
+	// not enough to register
+	msg.Coin = common.NewCoin(common.RuneNative, sdk.NewUint(uint64(registerFee)))
+	c.Assert(handler.validate(ctx, msg, constants.SWVersion, constAccessor), NotNil)
+
+	// only the owner can change a registered THORName
+	c.Assert(k.SetTHORName(ctx, NewTHORName("bob", ctx.BlockHeight()+100, owner)), IsNil)
+	msg.Coin = common.NewCoin(common.RuneNative, sdk.ZeroUint())
+	c.Assert(handler.validate(ctx, msg, constants.SWVersion, constAccessor), IsNil)
+	msg.Signer = GetRandomBech32Addr()
+	c.Assert(handler.validate(ctx, msg, constants.SWVersion, constAccessor).Code(), Equals, sdk.CodeUnauthorized)
+
+	// anyone can register an expired THORName
+	msg.Coin = coin
+	c.Assert(handler.validate(ctx.WithBlockHeight(ctx.BlockHeight()+100), msg, constants.SWVersion, constAccessor), IsNil)
+}
+
+func (s *HandlerManageTHORNameSuite) TestHandle(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	handler := NewManageTHORNameHandler(k)
+	registerFee := constAccessor.GetInt64Value(constants.TNSRegisterFee)
+	feePerBlock := constAccessor.GetInt64Value(constants.TNSFeePerBlock)
+	transactionFee := constAccessor.GetInt64Value(constants.TransactionFee)
+
+	owner := GetRandomBech32Addr()
+	bnbAddr := GetRandomBNBAddress()
+	coin := common.NewCoin(common.RuneNative, sdk.NewUint(uint64(registerFee+100*feePerBlock)))
+	msg := NewMsgManageTHORName("bob", common.BNBChain, bnbAddr, coin, owner)
+
+	// insufficient funds
+	result := handler.Run(ctx, msg, constants.SWVersion, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeInsufficientCoins)
+
+	funds, err := common.NewCoin(common.RuneNative, sdk.NewUint(200*common.One)).Native()
+	c.Assert(err, IsNil)
+	_, err = k.CoinKeeper().AddCoins(ctx, owner, sdk.NewCoins(funds))
+	c.Assert(err, IsNil)
+
+	// register
+	result = handler.Run(ctx, msg, constants.SWVersion, constAccessor)
+	c.Assert(result.IsOK(), Equals, true, Commentf("%+v", result.Log))
+	tn, err := k.GetTHORName(ctx, "bob")
+	c.Assert(err, IsNil)
+	c.Check(tn.Owner.Equals(owner), Equals, true)
+	c.Check(tn.ExpireBlockHeight, Equals, int64(110))
+	c.Check(tn.GetAlias(common.BNBChain).Equals(bnbAddr), Equals, true)
+
+	// renew and set another alias
+	btcAddr := GetRandomBTCAddress()
+	msg = NewMsgManageTHORName("bob", common.BTCChain, btcAddr, common.NewCoin(common.RuneNative, sdk.NewUint(uint64(50*feePerBlock))), owner)
+	result = handler.Run(ctx, msg, constants.SWVersion, constAccessor)
+	c.Assert(result.IsOK(), Equals, true, Commentf("%+v", result.Log))
+	tn, err = k.GetTHORName(ctx, "bob")
+	c.Assert(err, IsNil)
+	c.Check(tn.ExpireBlockHeight, Equals, int64(160))
+	c.Check(tn.GetAlias(common.BNBChain).Equals(bnbAddr), Equals, true)
+	c.Check(tn.GetAlias(common.BTCChain).Equals(btcAddr), Equals, true)
+
+	// the fees go to the reserve
+	expected := uint64(registerFee + 150*feePerBlock + 2*transactionFee)
+	c.Check(k.GetRuneBalaceOfModule(ctx, ReserveName).Equal(sdk.NewUint(expected)), Equals, true)
+
+	// someone else takes over the expired THORName
+	newOwner := GetRandomBech32Addr()
+	_, err = k.CoinKeeper().AddCoins(ctx, newOwner, sdk.NewCoins(funds))
+	c.Assert(err, IsNil)
+	msg = NewMsgManageTHORName("bob", common.BTCChain, GetRandomBTCAddress(), coin, newOwner)
+	result = handler.Run(ctx.WithBlockHeight(160), msg, constants.SWVersion, constAccessor)
+	c.Assert(result.IsOK(), Equals, true, Commentf("%+v", result.Log))
+	tn, err = k.GetTHORName(ctx, "bob")
+	c.Assert(err, IsNil)
+	c.Check(tn.Owner.Equals(newOwner), Equals, true)
+	c.Check(tn.ExpireBlockHeight, Equals, int64(260))
+	c.Check(tn.GetAlias(common.BNBChain).IsEmpty(), Equals, true)
+
+	// wrong message type
+	result = handler.Run(ctx, NewMsgSetIPAddress("8.8.8.8", owner), constants.SWVersion, constAccessor)
+	c.Assert(result.Code, Equals, errInvalidMessage.Code())
+}
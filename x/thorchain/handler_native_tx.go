@@ -66,7 +66,7 @@ func (h NativeTxHandler) validateV1(ctx sdk.Context, msg MsgNativeTx) error {
 		return err
 	}
 
-	memo, _ := ParseMemo(msg.Memo) // ignore err
+	memo, _ := ParseMemoWithTHORNames(ctx, h.keeper, resolveMemoRef(ctx, h.keeper, msg.Memo)) // ignore err
 	if !memo.IsInbound() {
 		// no one should send an outbound tx to vault
 		return errors.New("transaction is not an inbound transaction")
@@ -141,7 +141,7 @@ func (h NativeTxHandler) handleV1(ctx sdk.Context, msg MsgNativeTx, version semv
 		return sdk.ErrInternal("fail to get to address").Result()
 	}
 
-	tx := common.NewTx(txID, from, to, msg.Coins, common.Gas{gas}, msg.Memo)
+	tx := common.NewTx(txID, from, to, msg.Coins, common.Gas{gas}, resolveMemoRef(ctx, h.keeper, msg.Memo))
 
 	handler := NewInternalHandler(h.keeper, h.versionedTxOutStore, h.validatorMgr, h.versionedVaultManager, h.versionedObserverManager, h.versionedGasMgr, h.versionedEventManager)
 
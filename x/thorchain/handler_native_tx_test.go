@@ -35,6 +35,26 @@ func (s *HandlerNativeTxSuite) TestValidate(c *C) {
 	err := handler.validate(ctx, msg, constants.SWVersion)
 	c.Assert(err, IsNil)
 
+	// the destination is a THORName
+	tn := NewTHORName("bob", 100, GetRandomBech32Addr())
+	tn.SetAlias(common.BNBChain, GetRandomBNBAddress())
+	c.Assert(k.SetTHORName(ctx, tn), IsNil)
+	err = handler.validate(ctx, NewMsgNativeTx(coins, "SWAP:BNB.BNB:bob", addr), constants.SWVersion)
+	c.Assert(err, IsNil)
+
+	// unknown THORName
+	err = handler.validate(ctx, NewMsgNativeTx(coins, "SWAP:BNB.BNB:alice", addr), constants.SWVersion)
+	c.Assert(err, NotNil)
+
+	// the memo is a memo reference
+	c.Assert(k.SetMemoRef(ctx, NewMemoRef(1, "SWAP:BNB.BNB:bob", addr, 1)), IsNil)
+	err = handler.validate(ctx, NewMsgNativeTx(coins, "REF:1", addr), constants.SWVersion)
+	c.Assert(err, IsNil)
+
+	// unknown memo reference
+	err = handler.validate(ctx, NewMsgNativeTx(coins, "REF:2", addr), constants.SWVersion)
+	c.Assert(err, NotNil)
+
 	// invalid version
 	err = handler.validate(ctx, msg, semver.Version{})
 	c.Assert(err, Equals, errInvalidVersion)
@@ -74,4 +94,10 @@ func (s *HandlerNativeTxSuite) TestHandle(c *C) {
 
 	result := handler.handle(ctx, msg, constants.SWVersion, constAccessor)
 	c.Assert(result.IsOK(), Equals, true, Commentf("%+v", result.Log))
+
+	// the memo is a memo reference
+	c.Assert(k.SetMemoRef(ctx, NewMemoRef(1, "ADD:BNB.BNB", addr, 1)), IsNil)
+	msg = NewMsgNativeTx(common.Coins{common.NewCoin(common.RuneNative, sdk.NewUint(common.One))}, "REF:1", addr)
+	result = handler.handle(ctx, msg, constants.SWVersion, constAccessor)
+	c.Assert(result.IsOK(), Equals, true, Commentf("%+v", result.Log))
 }
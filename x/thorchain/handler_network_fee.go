@@ -0,0 +1,131 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+// NetworkFeeHandler is to handle MsgNetworkFee, the fee rate of a chain reported by bifrost
+type NetworkFeeHandler struct {
+	keeper Keeper
+}
+
+// NewNetworkFeeHandler create a new instance of NetworkFeeHandler
+func NewNetworkFeeHandler(keeper Keeper) NetworkFeeHandler {
+	return NetworkFeeHandler{
+		keeper: keeper,
+	}
+}
+
+// Run is the main entry point of NetworkFeeHandler
+func (h NetworkFeeHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	msg, ok := m.(MsgNetworkFee)
+	if !ok {
+		return errInvalidMessage.Result()
+	}
+	if err := h.validate(ctx, msg, version); err != nil {
+		ctx.Logger().Error("msg network fee failed validation", "error", err)
+		return err.Result()
+	}
+	return h.handle(ctx, msg, version, constAccessor)
+}
+
+func (h NetworkFeeHandler) validate(ctx sdk.Context, msg MsgNetworkFee, version semver.Version) sdk.Error {
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.validateV1(ctx, msg)
+	}
+	return errBadVersion
+}
+
+func (h NetworkFeeHandler) validateV1(ctx sdk.Context, msg MsgNetworkFee) sdk.Error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if !isSignedByActiveNodeAccounts(ctx, h.keeper, msg.GetSigners()) {
+		return sdk.ErrUnauthorized(notAuthorized.Error())
+	}
+	return nil
+}
+
+func (h NetworkFeeHandler) handle(ctx sdk.Context, msg MsgNetworkFee, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	ctx.Logger().Info("handleMsgNetworkFee request", "chain", msg.Chain.String(), "transaction_size", msg.TransactionSize, "fee_rate", msg.TransactionFeeRate)
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.handleV1(ctx, msg, constAccessor)
+	}
+	ctx.Logger().Error(errInvalidVersion.Error())
+	return errBadVersion.Result()
+}
+
+// handleV1 save the network fee reported by the node, once a simple majority of the active nodes reported a fee rate
+// within the last NetworkFeeReportExpiry blocks, the median of their reports become the network fee of the chain.
+// Reports older than NetworkFeeReportExpiry blocks are deleted
+func (h NetworkFeeHandler) handleV1(ctx sdk.Context, msg MsgNetworkFee, constAccessor constants.ConstantValues) sdk.Result {
+	active, err := h.keeper.ListActiveNodeAccounts(ctx)
+	if err != nil {
+		err = wrapError(ctx, err, "fail to get list of active node accounts")
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+	reports, err := h.keeper.GetNetworkFeeReports(ctx, msg.Chain)
+	if err != nil {
+		err = wrapError(ctx, err, "fail to get network fee reports")
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+	for _, report := range reports {
+		// ignore reports that are older than the one the node already made
+		if report.NodeAddress.Equals(msg.Signer) && report.BlockHeight > msg.BlockHeight {
+			return sdk.Result{
+				Code:      sdk.CodeOK,
+				Codespace: DefaultCodespace,
+			}
+		}
+	}
+	report := NetworkFeeReport{
+		NodeAddress:        msg.Signer,
+		BlockHeight:        msg.BlockHeight,
+		ReportHeight:       ctx.BlockHeight(),
+		Chain:              msg.Chain,
+		TransactionSize:    msg.TransactionSize,
+		TransactionFeeRate: msg.TransactionFeeRate,
+	}
+	h.keeper.SetNetworkFeeReport(ctx, report)
+
+	// only take the recent reports of the nodes that are still active into account,
+	// the expired ones are deleted
+	expiry := constAccessor.GetInt64Value(constants.NetworkFeeReportExpiry)
+	for _, item := range reports {
+		if !item.NodeAddress.Equals(msg.Signer) && item.IsExpired(ctx.BlockHeight(), expiry) {
+			h.keeper.RemoveNetworkFeeReport(ctx, item)
+		}
+	}
+	var activeReports NetworkFeeReports
+	for _, item := range reports.Fresh(ctx.BlockHeight(), expiry) {
+		if item.NodeAddress.Equals(msg.Signer) {
+			continue
+		}
+		for _, na := range active {
+			if na.NodeAddress.Equals(item.NodeAddress) {
+				activeReports = append(activeReports, item)
+				break
+			}
+		}
+	}
+	activeReports = append(activeReports, report)
+	if !HasSimpleMajority(len(activeReports), len(active)) {
+		ctx.Logger().Info("not enough network fee reports yet", "chain", msg.Chain.String())
+		return sdk.Result{
+			Code:      sdk.CodeOK,
+			Codespace: DefaultCodespace,
+		}
+	}
+	networkFee := activeReports.Median(msg.Chain)
+	if err := h.keeper.SaveNetworkFee(ctx, msg.Chain, networkFee); err != nil {
+		err = wrapError(ctx, err, "fail to save network fee")
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+	return sdk.Result{
+		Code:      sdk.CodeOK,
+		Codespace: DefaultCodespace,
+	}
+}
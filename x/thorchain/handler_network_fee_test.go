@@ -0,0 +1,109 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type HandlerNetworkFeeSuite struct{}
+
+var _ = Suite(&HandlerNetworkFeeSuite{})
+
+func (HandlerNetworkFeeSuite) TestNetworkFee(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	handler := NewNetworkFeeHandler(k)
+
+	active := NodeAccounts{
+		GetRandomNodeAccount(NodeActive),
+		GetRandomNodeAccount(NodeActive),
+		GetRandomNodeAccount(NodeActive),
+	}
+	for _, na := range active {
+		c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	}
+
+	// invalid version
+	msg := NewMsgNetworkFee(1024, common.BTCChain, 250, 10, active[0].NodeAddress)
+	c.Assert(handler.validate(ctx, msg, semver.Version{}), Equals, errBadVersion)
+
+	// invalid msg
+	result := handler.Run(ctx, MsgNetworkFee{}, ver, constAccessor)
+	c.Assert(result.Code, Not(Equals), sdk.CodeOK)
+
+	// wrong message type
+	result = handler.Run(ctx, NewMsgSetIPAddress("8.8.8.8", active[0].NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, errInvalidMessage.Code())
+
+	// not signed by an active node account
+	result = handler.Run(ctx, NewMsgNetworkFee(1024, common.BTCChain, 250, 10, GetRandomBech32Addr()), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnauthorized)
+
+	// a single report is not enough
+	result = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+	networkFee, err := k.GetNetworkFee(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(networkFee.IsEmpty(), Equals, true)
+
+	// the same node reporting again doesn't count twice
+	result = handler.Run(ctx, NewMsgNetworkFee(1025, common.BTCChain, 250, 12, active[0].NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+	networkFee, err = k.GetNetworkFee(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(networkFee.IsEmpty(), Equals, true)
+
+	// a stale report is ignored
+	result = handler.Run(ctx, NewMsgNetworkFee(1000, common.BTCChain, 250, 1000, active[0].NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+
+	result = handler.Run(ctx, NewMsgNetworkFee(1025, common.BTCChain, 250, 30, active[1].NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+	networkFee, err = k.GetNetworkFee(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(networkFee.TransactionSize, Equals, uint64(250))
+	c.Assert(networkFee.TransactionFeeRate, Equals, uint64(21))
+
+	result = handler.Run(ctx, NewMsgNetworkFee(1025, common.BTCChain, 300, 20, active[2].NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+	networkFee, err = k.GetNetworkFee(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(networkFee.TransactionSize, Equals, uint64(250))
+	c.Assert(networkFee.TransactionFeeRate, Equals, uint64(20))
+
+	// reports from nodes that are no longer active are ignored
+	na := active[2]
+	na.UpdateStatus(NodeStandby, ctx.BlockHeight())
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	result = handler.Run(ctx, NewMsgNetworkFee(1026, common.BTCChain, 250, 40, active[1].NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+	networkFee, err = k.GetNetworkFee(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(networkFee.TransactionFeeRate, Equals, uint64(26))
+
+	// reports older than NetworkFeeReportExpiry blocks are ignored
+	expiry := constAccessor.GetInt64Value(constants.NetworkFeeReportExpiry)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + expiry + 1)
+	na.UpdateStatus(NodeActive, ctx.BlockHeight())
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	result = handler.Run(ctx, NewMsgNetworkFee(1100, common.BTCChain, 250, 50, active[1].NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+	networkFee, err = k.GetNetworkFee(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(networkFee.TransactionFeeRate, Equals, uint64(26))
+	// and deleted, only the report just made is left
+	reports, err := k.GetNetworkFeeReports(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(reports, HasLen, 1)
+	c.Check(reports[0].NodeAddress.Equals(active[1].NodeAddress), Equals, true)
+	result = handler.Run(ctx, NewMsgNetworkFee(1100, common.BTCChain, 250, 60, active[0].NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+	networkFee, err = k.GetNetworkFee(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(networkFee.TransactionFeeRate, Equals, uint64(55))
+}
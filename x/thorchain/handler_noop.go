@@ -0,0 +1,54 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+// NoOpHandler is to handle MsgNoOp, observed txs that should not change any pool
+type NoOpHandler struct {
+	keeper Keeper
+}
+
+// NewNoOpHandler create a new instance of NoOpHandler
+func NewNoOpHandler(keeper Keeper) NoOpHandler {
+	return NoOpHandler{
+		keeper: keeper,
+	}
+}
+
+// Run is the main entry point of NoOpHandler
+func (h NoOpHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Version, _ constants.ConstantValues) sdk.Result {
+	msg, ok := m.(MsgNoOp)
+	if !ok {
+		return errInvalidMessage.Result()
+	}
+	if err := h.validate(ctx, msg, version); err != nil {
+		ctx.Logger().Error("msg noop failed validation", "error", err)
+		return err.Result()
+	}
+	ctx.Logger().Info("handleMsgNoOp request", "tx", msg.ObservedTx.Tx.ID.String(), "memo", msg.ObservedTx.Tx.Memo)
+	return sdk.Result{
+		Code:      sdk.CodeOK,
+		Codespace: DefaultCodespace,
+	}
+}
+
+func (h NoOpHandler) validate(ctx sdk.Context, msg MsgNoOp, version semver.Version) sdk.Error {
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.validateV1(ctx, msg)
+	}
+	return errBadVersion
+}
+
+func (h NoOpHandler) validateV1(ctx sdk.Context, msg MsgNoOp) sdk.Error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if !isSignedByActiveNodeAccounts(ctx, h.keeper, msg.GetSigners()) {
+		return sdk.ErrUnauthorized("not authorized")
+	}
+	return nil
+}
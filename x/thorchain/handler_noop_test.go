@@ -0,0 +1,69 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type HandlerNoOpSuite struct{}
+
+var _ = Suite(&HandlerNoOpSuite{})
+
+type TestNoOpKeeper struct {
+	KVStoreDummy
+	activeNodeAccount NodeAccount
+}
+
+func (k *TestNoOpKeeper) GetNodeAccount(_ sdk.Context, addr sdk.AccAddress) (NodeAccount, error) {
+	if k.activeNodeAccount.NodeAddress.Equals(addr) {
+		return k.activeNodeAccount, nil
+	}
+	return NodeAccount{}, nil
+}
+
+func (HandlerNoOpSuite) TestNoOp(c *C) {
+	ctx, _ := setupKeeperForTest(c)
+
+	keeper := &TestNoOpKeeper{
+		activeNodeAccount: GetRandomNodeAccount(NodeActive),
+	}
+	handler := NewNoOpHandler(keeper)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+
+	addr, err := GetRandomPubKey().GetAddress(common.BTCChain)
+	c.Assert(err, IsNil)
+	tx := NewObservedTx(common.Tx{
+		ID:          GetRandomTxHash(),
+		Chain:       common.BTCChain,
+		Coins:       common.Coins{common.NewCoin(common.BTCAsset, sdk.NewUint(common.One))},
+		Memo:        NewConsolidateMemo().String(),
+		FromAddress: addr,
+		ToAddress:   addr,
+		Gas:         common.Gas{common.NewCoin(common.BTCAsset, sdk.NewUint(10000))},
+	}, 12, GetRandomPubKey())
+
+	msg := NewMsgNoOp(tx, keeper.activeNodeAccount.NodeAddress)
+	result := handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK)
+
+	// invalid version
+	c.Assert(handler.validate(ctx, msg, semver.Version{}), Equals, errBadVersion)
+
+	// invalid msg
+	result = handler.Run(ctx, MsgNoOp{}, ver, constAccessor)
+	c.Assert(result.Code, Not(Equals), sdk.CodeOK)
+
+	// not signed by an active node account
+	msg = NewMsgNoOp(tx, GetRandomBech32Addr())
+	result = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnauthorized)
+
+	// wrong message type
+	result = handler.Run(ctx, NewMsgSetIPAddress("8.8.8.8", keeper.activeNodeAccount.NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, errInvalidMessage.Code())
+}
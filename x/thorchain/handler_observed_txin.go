@@ -90,16 +90,31 @@ func (h ObservedTxInHandler) handle(ctx sdk.Context, msg MsgObservedTxIn, versio
 	}
 }
 
-func (h ObservedTxInHandler) preflight(ctx sdk.Context, voter ObservedTxVoter, nas NodeAccounts, tx ObservedTx, signer sdk.AccAddress) (ObservedTxVoter, bool) {
+func (h ObservedTxInHandler) preflight(ctx sdk.Context, voter ObservedTxVoter, nas NodeAccounts, tx ObservedTx, signer sdk.AccAddress, constAccessor constants.ConstantValues) (ObservedTxVoter, bool) {
+	if voter.FirstObservedHeight == 0 {
+		voter.FirstObservedHeight = ctx.BlockHeight()
+	}
+	if !voter.HasSigned(signer) {
+		// observations arriving after the block the tx reached consensus are too late
+		missedConsensus := voter.ProcessedIn && voter.Height < ctx.BlockHeight()
+		recordObservation(ctx, h.keeper, signer, tx, ctx.BlockHeight()-voter.FirstObservedHeight, missedConsensus)
+	}
 	voter.Add(tx, signer)
 
+	// observers could disagree on the tx, each version of it must reach its
+	// own threshold
 	ok := false
-	if voter.HasConsensus(nas) && !voter.ProcessedIn {
-		ok = true
-		voter.Height = ctx.BlockHeight()
-		voter.ProcessedIn = true
-		// this is the tx that has consensus
-		voter.Tx = voter.GetTx(nas)
+	if !voter.ProcessedIn {
+		for _, item := range voter.Txs {
+			if item.HasThreshold(nas, h.getObservationThreshold(ctx, item, constAccessor)) {
+				ok = true
+				voter.Height = ctx.BlockHeight()
+				voter.ProcessedIn = true
+				// this is the tx that has consensus
+				voter.Tx = item
+				break
+			}
+		}
 	}
 	h.keeper.SetObservedTxVoter(ctx, voter)
 
@@ -107,6 +122,62 @@ func (h ObservedTxInHandler) preflight(ctx sdk.Context, voter ObservedTxVoter, n
 	return voter, ok
 }
 
+// maxObservationThreshold is the highest observation threshold, every active node
+const maxObservationThreshold = 10000
+
+// getObservationThreshold return the share of active nodes (basis points)
+// required to observe the given inbound tx. The threshold can be set per chain
+// with mimir (ie ObservationThreshold-BTC), and a higher one applies to large
+// inbound txs
+func (h ObservedTxInHandler) getObservationThreshold(ctx sdk.Context, tx ObservedTx, constAccessor constants.ConstantValues) int64 {
+	key := fmt.Sprintf("%s-%s", constants.ObservationThreshold, tx.Tx.Chain)
+	threshold, err := h.keeper.GetMimir(ctx, key)
+	if threshold < 0 || err != nil {
+		threshold, err = h.keeper.GetMimir(ctx, constants.ObservationThreshold.String())
+		if threshold < 0 || err != nil {
+			threshold = constAccessor.GetInt64Value(constants.ObservationThreshold)
+		}
+	}
+	if threshold > maxObservationThreshold {
+		threshold = maxObservationThreshold
+	}
+
+	largeValue, err := h.keeper.GetMimir(ctx, constants.LargeObservationRuneValue.String())
+	if largeValue < 0 || err != nil {
+		largeValue = constAccessor.GetInt64Value(constants.LargeObservationRuneValue)
+	}
+	if largeValue <= 0 {
+		return threshold
+	}
+	runeValue := sdk.ZeroUint()
+	for _, coin := range tx.Tx.Coins {
+		if coin.Asset.IsRune() {
+			runeValue = runeValue.Add(coin.Amount)
+			continue
+		}
+		pool, err := h.keeper.GetPool(ctx, coin.Asset)
+		if err != nil {
+			ctx.Logger().Error("fail to get pool", "error", err)
+			continue
+		}
+		runeValue = runeValue.Add(pool.AssetValueInRune(coin.Amount))
+	}
+	if runeValue.LT(sdk.NewUint(uint64(largeValue))) {
+		return threshold
+	}
+	largeThreshold, err := h.keeper.GetMimir(ctx, constants.LargeObservationThreshold.String())
+	if largeThreshold < 0 || err != nil {
+		largeThreshold = constAccessor.GetInt64Value(constants.LargeObservationThreshold)
+	}
+	if largeThreshold > maxObservationThreshold {
+		largeThreshold = maxObservationThreshold
+	}
+	if largeThreshold > threshold {
+		return largeThreshold
+	}
+	return threshold
+}
+
 // Handle a message to observe inbound tx
 func (h ObservedTxInHandler) handleV1(ctx sdk.Context, version semver.Version, msg MsgObservedTxIn) sdk.Result {
 	constAccessor := constants.GetConstantValues(version)
@@ -145,7 +216,7 @@ func (h ObservedTxInHandler) handleV1(ctx sdk.Context, version semver.Version, m
 			return sdk.ErrInternal(err.Error()).Result()
 		}
 
-		voter, ok := h.preflight(ctx, voter, activeNodeAccounts, tx, msg.Signer)
+		voter, ok := h.preflight(ctx, voter, activeNodeAccounts, tx, msg.Signer, constAccessor)
 		if !ok {
 			if voter.Height == ctx.BlockHeight() {
 				// we've already process the transaction, but we should still
@@ -166,6 +237,7 @@ func (h ObservedTxInHandler) handleV1(ctx sdk.Context, version semver.Version, m
 			}
 			tx.Tx.Memo = fetchMemo(ctx, constAccessor, h.keeper, txYgg)
 		}
+		tx.Tx.Memo = resolveMemoRef(ctx, h.keeper, tx.Tx.Memo)
 
 		ctx.Logger().Info("handleMsgObservedTxIn request", "Tx:", tx.String())
 
@@ -179,7 +251,7 @@ func (h ObservedTxInHandler) handleV1(ctx sdk.Context, version semver.Version, m
 
 		vault.AddFunds(tx.Tx.Coins)
 		vault.InboundTxCount += 1
-		memo, _ := ParseMemo(tx.Tx.Memo) // ignore err
+		memo, _ := ParseMemoWithTHORNames(ctx, h.keeper, tx.Tx.Memo) // ignore err
 		if vault.IsYggdrasil() && memo.IsType(TxYggdrasilFund) {
 			vault.RemovePendingTxBlockHeights(memo.GetBlockHeight())
 		}
@@ -133,6 +133,14 @@ type TestObservedTxInHandleKeeper struct {
 	observing []sdk.AccAddress
 	vault     Vault
 	txOut     *TxOut
+	memoRef   MemoRef
+}
+
+func (k *TestObservedTxInHandleKeeper) GetMemoRef(_ sdk.Context, id int64) (MemoRef, error) {
+	if k.memoRef.ID == id {
+		return k.memoRef, nil
+	}
+	return MemoRef{}, nil
 }
 
 func (k *TestObservedTxInHandleKeeper) SetSwapQueueItem(_ sdk.Context, msg MsgSwap) error {
@@ -270,6 +278,92 @@ func (s *HandlerObservedTxInSuite) TestHandle(c *C) {
 	c.Assert(bnbCoin.Amount.Equal(sdk.OneUint()), Equals, true)
 }
 
+func (s *HandlerObservedTxInSuite) TestHandleMemoRef(c *C) {
+	ctx, _ := setupKeeperForTest(c)
+	w := getHandlerTestWrapper(c, 1, true, false)
+	ver := constants.SWVersion
+
+	swapMemo := "SWAP:BTC.BTC:" + GetRandomBTCAddress().String()
+	tx := GetRandomTx()
+	tx.Memo = "REF:7"
+	obTx := NewObservedTx(tx, 12, GetRandomPubKey())
+	vault := GetRandomVault()
+	vault.PubKey = obTx.ObservedPubKey
+
+	keeper := &TestObservedTxInHandleKeeper{
+		nas:   NodeAccounts{GetRandomNodeAccount(NodeActive)},
+		voter: NewObservedTxVoter(tx.ID, make(ObservedTxs, 0)),
+		vault: vault,
+		pool: Pool{
+			Asset:        common.BNBAsset,
+			BalanceRune:  sdk.NewUint(200),
+			BalanceAsset: sdk.NewUint(300),
+		},
+		yggExists: true,
+		memoRef:   NewMemoRef(7, swapMemo, GetRandomBech32Addr(), 1),
+	}
+	versionedTxOutStore := NewVersionedTxOutStoreDummy()
+	handler := NewObservedTxInHandler(keeper, NewVersionedObserverMgr(), versionedTxOutStore, w.validatorMgr, NewVersionedVaultMgrDummy(versionedTxOutStore), NewVersionedGasMgr(), NewDummyVersionedEventMgr())
+
+	msg := NewMsgObservedTxIn(ObservedTxs{obTx}, keeper.nas[0].NodeAddress)
+	result := handler.handle(ctx, msg, ver)
+	c.Assert(result.IsOK(), Equals, true, Commentf("%s", result.Log))
+	// the swap is queued with the registered memo
+	c.Check(keeper.msg.Tx.ID.Equals(tx.ID), Equals, true)
+	c.Check(keeper.msg.Tx.Memo, Equals, swapMemo)
+}
+
+func (s *HandlerObservedTxInSuite) TestConsolidateMemoNotFromVault(c *C) {
+	ctx, _ := setupKeeperForTest(c)
+	w := getHandlerTestWrapper(c, 1, true, false)
+	ver := constants.SWVersion
+
+	vault := GetRandomVault()
+	vaultAddr, err := vault.PubKey.GetAddress(common.BTCChain)
+	c.Assert(err, IsNil)
+	// anyone but the vault itself sending a consolidate memo get refunded
+	tx := NewObservedTx(common.Tx{
+		ID:          GetRandomTxHash(),
+		Chain:       common.BTCChain,
+		Coins:       common.Coins{common.NewCoin(common.BTCAsset, sdk.NewUint(common.One))},
+		Memo:        NewConsolidateMemo().String(),
+		FromAddress: GetRandomBTCAddress(),
+		ToAddress:   vaultAddr,
+		Gas:         common.Gas{common.NewCoin(common.BTCAsset, sdk.NewUint(10000))},
+	}, 12, vault.PubKey)
+
+	keeper := &TestObservedTxInHandleKeeper{
+		nas:   NodeAccounts{GetRandomNodeAccount(NodeActive)},
+		voter: NewObservedTxVoter(tx.Tx.ID, make(ObservedTxs, 0)),
+		vault: vault,
+		pool: Pool{
+			Asset:        common.BTCAsset,
+			BalanceRune:  sdk.NewUint(200),
+			BalanceAsset: sdk.NewUint(300),
+		},
+		yggExists: true,
+	}
+	versionedTxOutStore := NewVersionedTxOutStoreDummy()
+	handler := NewObservedTxInHandler(keeper, NewDummyVersionedObserverMgr(), versionedTxOutStore, w.validatorMgr, NewVersionedVaultMgrDummy(versionedTxOutStore), NewVersionedGasMgr(), NewDummyVersionedEventMgr())
+
+	msg := NewMsgObservedTxIn(ObservedTxs{tx}, keeper.nas[0].NodeAddress)
+	result := handler.handle(ctx, msg, ver)
+	c.Assert(result.IsOK(), Equals, true, Commentf("%s", result.Log))
+	txOutStore, err := versionedTxOutStore.GetTxOutStore(ctx, keeper, ver)
+	c.Assert(err, IsNil)
+	items, err := txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].ToAddress.Equals(tx.Tx.FromAddress), Equals, true)
+	c.Check(items[0].Memo, Equals, NewRefundMemo(tx.Tx.ID).String())
+
+	// the vault consolidating its own utxos is accepted
+	tx.Tx.FromAddress = vaultAddr
+	m, txErr := processOneTxIn(ctx, keeper, tx, keeper.nas[0].NodeAddress)
+	c.Assert(txErr, IsNil)
+	c.Check(m.Type(), Equals, NewMsgNoOp(tx, keeper.nas[0].NodeAddress).Type())
+}
+
 // Test migrate memo
 func (s *HandlerObservedTxInSuite) TestMigrateMemo(c *C) {
 	var err error
@@ -332,3 +426,127 @@ func (s *HandlerObservedTxInSuite) TestMigrateMemo(c *C) {
 	result := handler.handle(ctx, msg, ver)
 	c.Assert(result.IsOK(), Equals, true)
 }
+
+func (s *HandlerObservedTxInSuite) TestObservationStats(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	nas := NodeAccounts{
+		GetRandomNodeAccount(NodeActive),
+		GetRandomNodeAccount(NodeActive),
+		GetRandomNodeAccount(NodeActive),
+	}
+	handler := NewObservedTxInHandler(keeper, NewVersionedObserverMgr(), NewVersionedTxOutStoreDummy(), NewVersionedValidatorDummyMgr(), NewVersionedVaultMgrDummy(NewVersionedTxOutStoreDummy()), NewVersionedGasMgr(), NewDummyVersionedEventMgr())
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+
+	tx := NewObservedTx(GetRandomTx(), 120, GetRandomPubKey())
+	voter := NewObservedTxVoter(tx.Tx.ID, nil)
+	voter, _ = handler.preflight(ctx, voter, nas, tx, nas[0].NodeAddress, constAccessor)
+	voter, ok := handler.preflight(ctx, voter, nas, tx, nas[1].NodeAddress, constAccessor)
+	c.Assert(ok, Equals, true)
+	// observe the same tx again doesn't count
+	voter, _ = handler.preflight(ctx, voter, nas, tx, nas[1].NodeAddress, constAccessor)
+
+	// the third node observe the tx three blocks after consensus
+	ctx = ctx.WithBlockHeight(13)
+	_, ok = handler.preflight(ctx, voter, nas, tx, nas[2].NodeAddress, constAccessor)
+	c.Assert(ok, Equals, false)
+
+	stats, err := keeper.GetObserverStats(ctx, nas[1].NodeAddress)
+	c.Assert(err, IsNil)
+	bnb := stats.GetChainStats(tx.Tx.Chain)
+	c.Check(bnb.LastObservedHeight, Equals, int64(120))
+	c.Check(bnb.Observations, Equals, int64(1))
+	c.Check(bnb.MissedConsensus, Equals, int64(0))
+	c.Check(bnb.AverageDelay(), Equals, int64(0))
+
+	stats, err = keeper.GetObserverStats(ctx, nas[2].NodeAddress)
+	c.Assert(err, IsNil)
+	bnb = stats.GetChainStats(tx.Tx.Chain)
+	c.Check(bnb.Observations, Equals, int64(1))
+	c.Check(bnb.MissedConsensus, Equals, int64(1))
+	c.Check(bnb.AverageDelay(), Equals, int64(3))
+}
+
+func (s *HandlerObservedTxInSuite) TestObservationThreshold(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	handler := NewObservedTxInHandler(keeper, NewVersionedObserverMgr(), NewVersionedTxOutStoreDummy(), NewVersionedValidatorDummyMgr(), NewVersionedVaultMgrDummy(NewVersionedTxOutStoreDummy()), NewVersionedGasMgr(), NewDummyVersionedEventMgr())
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceRune = sdk.NewUint(1000 * common.One)
+	pool.BalanceAsset = sdk.NewUint(10 * common.One)
+	c.Assert(keeper.SetPool(ctx, pool), IsNil)
+
+	nas := make(NodeAccounts, 10)
+	for i := range nas {
+		nas[i] = GetRandomNodeAccount(NodeActive)
+	}
+	observe := func(tx ObservedTx) int {
+		voter := NewObservedTxVoter(tx.Tx.ID, nil)
+		for i, na := range nas {
+			var ok bool
+			voter, ok = handler.preflight(ctx, voter, nas, tx, na.NodeAddress, constAccessor)
+			if ok {
+				return i + 1
+			}
+		}
+		return 0
+	}
+	newTx := func(coin common.Coin) ObservedTx {
+		tx := GetRandomObservedTx()
+		tx.Tx.Chain = coin.Asset.Chain
+		tx.Tx.Coins = common.Coins{coin}
+		return tx
+	}
+	smallBTC := common.NewCoin(common.BTCAsset, sdk.NewUint(common.One))
+	largeBTC := common.NewCoin(common.BTCAsset, sdk.NewUint(5*common.One))
+	bnb := common.NewCoin(common.BNBAsset, sdk.NewUint(common.One))
+
+	// default is the supermajority
+	c.Check(observe(newTx(smallBTC)), Equals, 6)
+
+	keeper.SetMimir(ctx, constants.ObservationThreshold.String()+"-BTC", 8000)
+	c.Check(observe(newTx(smallBTC)), Equals, 8)
+	c.Check(observe(newTx(bnb)), Equals, 6)
+
+	// inbound worth 500 RUNE or more are large
+	keeper.SetMimir(ctx, constants.LargeObservationRuneValue.String(), 500*common.One)
+	c.Check(observe(newTx(smallBTC)), Equals, 8)
+	c.Check(observe(newTx(largeBTC)), Equals, 9)
+
+	// can't go lower than supermajority
+	keeper.SetMimir(ctx, constants.ObservationThreshold.String()+"-BTC", 1000)
+	c.Check(observe(newTx(smallBTC)), Equals, 6)
+	// thresholds are capped to every active node
+	keeper.SetMimir(ctx, constants.ObservationThreshold.String()+"-BTC", 20000)
+	c.Check(observe(newTx(smallBTC)), Equals, 10)
+	keeper.SetMimir(ctx, constants.ObservationThreshold.String()+"-BTC", 0)
+
+	// each version of the tx needs its own threshold
+	small := newTx(smallBTC)
+	large := small
+	large.Tx.Coins = common.Coins{largeBTC}
+	voter := NewObservedTxVoter(small.Tx.ID, nil)
+	voter, ok := handler.preflight(ctx, voter, nas, large, nas[0].NodeAddress, constAccessor)
+	c.Check(ok, Equals, false)
+	for i := 1; i < 6; i++ {
+		voter, ok = handler.preflight(ctx, voter, nas, small, nas[i].NodeAddress, constAccessor)
+		c.Check(ok, Equals, false)
+	}
+	voter, ok = handler.preflight(ctx, voter, nas, small, nas[6].NodeAddress, constAccessor)
+	c.Check(ok, Equals, true)
+	c.Check(voter.Tx.Equals(small), Equals, true)
+
+	// a large tx never falls back to the supermajority, however long it waits
+	voter = NewObservedTxVoter(large.Tx.ID, nil)
+	for i := 0; i < 7; i++ {
+		voter, ok = handler.preflight(ctx, voter, nas, large, nas[i].NodeAddress, constAccessor)
+		c.Check(ok, Equals, false)
+	}
+	voter, ok = handler.preflight(ctx.WithBlockHeight(ctx.BlockHeight()+1000), voter, nas, large, nas[7].NodeAddress, constAccessor)
+	c.Check(ok, Equals, false)
+	// unless the threshold is lowered with mimir
+	keeper.SetMimir(ctx, constants.LargeObservationThreshold.String(), 0)
+	_, ok = handler.preflight(ctx, voter, nas, large, nas[8].NodeAddress, constAccessor)
+	c.Check(ok, Equals, true)
+}
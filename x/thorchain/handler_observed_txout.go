@@ -82,6 +82,14 @@ func (h ObservedTxOutHandler) handle(ctx sdk.Context, msg MsgObservedTxOut, vers
 }
 
 func (h ObservedTxOutHandler) preflight(ctx sdk.Context, voter ObservedTxVoter, nas NodeAccounts, tx ObservedTx, signer sdk.AccAddress) (ObservedTxVoter, bool) {
+	if voter.FirstObservedHeight == 0 {
+		voter.FirstObservedHeight = ctx.BlockHeight()
+	}
+	if !voter.HasSigned(signer) {
+		// observations arriving after the block the tx reached consensus are too late
+		missedConsensus := voter.ProcessedOut && voter.Height < ctx.BlockHeight()
+		recordObservation(ctx, h.keeper, signer, tx, ctx.BlockHeight()-voter.FirstObservedHeight, missedConsensus)
+	}
 	voter.Add(tx, signer)
 	ok := false
 	if voter.HasConsensus(nas) && !voter.ProcessedOut {
@@ -210,6 +218,12 @@ func (h ObservedTxOutHandler) handleV1(ctx sdk.Context, version semver.Version,
 		if vault.IsAsgard() && memo.IsType(TxMigrate) {
 			// only remove the block height that had been specified in the memo
 			vault.RemovePendingTxBlockHeights(memo.GetBlockHeight())
+			if err := recordMigration(ctx, h.keeper, vault, tx.Tx); err != nil {
+				ctx.Logger().Error("fail to record migration", "error", err)
+			}
+		}
+		if vault.IsYggdrasil() && memo.IsType(TxYggdrasilReturn) {
+			vault.RemovePendingTxBlockHeights(memo.GetBlockHeight())
 		}
 		if err := h.keeper.SetVault(ctx, vault); err != nil {
 			ctx.Logger().Error("fail to save vault", "error", err)
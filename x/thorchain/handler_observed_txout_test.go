@@ -357,3 +357,57 @@ func (s *HandlerObservedTxOutSuite) TestHandleStolenFunds(c *C) {
 	c.Check(ygg.Coins.GetCoin(common.BNBAsset).Amount.Equal(sdk.NewUint(9999962500)), Equals, true, Commentf("%d", ygg.Coins.GetCoin(common.BNBAsset).Amount.Uint64()))
 	c.Assert(keeper.na.Bond.LT(sdk.NewUint(1000000*common.One)), Equals, true, Commentf("%d", keeper.na.Bond.Uint64()))
 }
+
+func (s *HandlerObservedTxOutSuite) TestHandleConsolidate(c *C) {
+	ctx, _ := setupKeeperForTest(c)
+	w := getHandlerTestWrapper(c, 1, true, false)
+
+	ver := constants.SWVersion
+	pk := GetRandomPubKey()
+	addr, err := pk.GetAddress(common.BTCChain)
+	c.Assert(err, IsNil)
+	tx := common.Tx{
+		ID:          GetRandomTxHash(),
+		Chain:       common.BTCChain,
+		FromAddress: addr,
+		ToAddress:   addr,
+		Coins:       common.Coins{common.NewCoin(common.BTCAsset, sdk.NewUint(2*common.One))},
+		Gas:         common.Gas{common.NewCoin(common.BTCAsset, sdk.NewUint(10000))},
+		Memo:        NewConsolidateMemo().String(),
+	}
+	txs := ObservedTxs{NewObservedTx(tx, 12, pk)}
+
+	na := GetRandomNodeAccount(NodeActive)
+	na.Bond = sdk.NewUint(1000000 * common.One)
+	na.PubKeySet.Secp256k1 = pk
+
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	ygg := NewVault(ctx.BlockHeight(), ActiveVault, YggdrasilVault, pk, common.Chains{common.BTCChain})
+	ygg.Coins = common.Coins{
+		common.NewCoin(common.BTCAsset, sdk.NewUint(3*common.One)),
+	}
+	keeper := &TestObservedTxOutHandleKeeper{
+		nas:       NodeAccounts{na},
+		voter:     NewObservedTxVoter(tx.ID, make(ObservedTxs, 0)),
+		yggExists: true,
+		ygg:       ygg,
+	}
+	txOutStore, err := versionedTxOutStoreDummy.GetTxOutStore(ctx, keeper, ver)
+	c.Assert(err, IsNil)
+	keeper.txOutStore = txOutStore
+	versionedGasMgr := NewVersionedGasMgr()
+
+	handler := NewObservedTxOutHandler(keeper, NewDummyVersionedObserverMgr(), versionedTxOutStoreDummy, w.validatorMgr, NewVersionedVaultMgrDummy(versionedTxOutStoreDummy), versionedGasMgr, NewDummyVersionedEventMgr())
+
+	msg := NewMsgObservedTxOut(txs, na.NodeAddress)
+	result := handler.handle(ctx, msg, ver)
+	c.Assert(result.IsOK(), Equals, true)
+	// the node should not be slashed for consolidating its utxos
+	c.Check(keeper.na.IsEmpty(), Equals, true)
+	// the coins sent to itself are added back by the inbound observation, only the gas is gone
+	c.Check(keeper.ygg.Coins.GetCoin(common.BTCAsset).Amount.Uint64(), Equals, uint64(common.One-10000))
+	// and the gas is reimbursed to the pool
+	gasMgr, err := versionedGasMgr.GetGasManager(ctx, ver)
+	c.Assert(err, IsNil)
+	c.Check(gasMgr.GetGas().ToCoins().GetCoin(common.BTCAsset).Amount.Uint64(), Equals, uint64(10000))
+}
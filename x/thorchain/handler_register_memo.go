@@ -0,0 +1,125 @@
+package thorchain
+
+import (
+	"errors"
+
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+// RegisterMemoHandler is to handle MsgRegisterMemo, it saves a memo that inbound txs can refer to by a short reference
+type RegisterMemoHandler struct {
+	keeper Keeper
+}
+
+// NewRegisterMemoHandler create a new instance of RegisterMemoHandler
+func NewRegisterMemoHandler(keeper Keeper) RegisterMemoHandler {
+	return RegisterMemoHandler{
+		keeper: keeper,
+	}
+}
+
+// Run is the main entry point of RegisterMemoHandler
+func (h RegisterMemoHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	msg, ok := m.(MsgRegisterMemo)
+	if !ok {
+		return errInvalidMessage.Result()
+	}
+	if err := h.validate(ctx, msg, version); err != nil {
+		return sdk.ErrUnknownRequest(err.Error()).Result()
+	}
+	return h.handle(ctx, msg, version, constAccessor)
+}
+
+func (h RegisterMemoHandler) validate(ctx sdk.Context, msg MsgRegisterMemo, version semver.Version) error {
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.validateV1(ctx, msg)
+	}
+	ctx.Logger().Error(errInvalidVersion.Error())
+	return errInvalidVersion
+}
+
+func (h RegisterMemoHandler) validateV1(ctx sdk.Context, msg MsgRegisterMemo) error {
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(err.Error())
+		return err
+	}
+	memo, err := ParseMemoWithTHORNames(ctx, h.keeper, msg.Memo)
+	if err != nil {
+		return err
+	}
+	if !memo.IsInbound() {
+		return errors.New("only memos of inbound transactions can be registered")
+	}
+	return nil
+}
+
+func (h RegisterMemoHandler) handle(ctx sdk.Context, msg MsgRegisterMemo, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	ctx.Logger().Info("receive MsgRegisterMemo", "from", msg.Signer, "memo", msg.Memo)
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.handleV1(ctx, msg, constAccessor)
+	}
+	ctx.Logger().Error(errInvalidVersion.Error())
+	return errBadVersion.Result()
+}
+
+func (h RegisterMemoHandler) handleV1(ctx sdk.Context, msg MsgRegisterMemo, constAccessor constants.ConstantValues) sdk.Result {
+	// registering a memo cost the same as a native tx, so the memo store can't be spammed for free
+	transactionFee := constAccessor.GetInt64Value(constants.TransactionFee)
+	gasFee, err := common.NewCoin(common.RuneNative, sdk.NewUint(uint64(transactionFee))).Native()
+	if err != nil {
+		ctx.Logger().Error("fail to get gas fee", "err", err)
+		return sdk.ErrInternal("fail to get gas fee").Result()
+	}
+	if !h.keeper.CoinKeeper().HasCoins(ctx, msg.Signer, sdk.NewCoins(gasFee)) {
+		return sdk.ErrInsufficientCoins("insufficient funds").Result()
+	}
+	if sdkErr := h.keeper.Supply().SendCoinsFromAccountToModule(ctx, msg.Signer, ReserveName, sdk.NewCoins(gasFee)); sdkErr != nil {
+		ctx.Logger().Error("unable to send gas to reserve", "error", sdkErr)
+		return sdkErr.Result()
+	}
+
+	id, err := h.keeper.GetNextMemoRefID(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get next memo ref id", "error", err)
+		return sdk.ErrInternal("fail to get next memo ref id").Result()
+	}
+	ref := NewMemoRef(id, msg.Memo, msg.Signer, ctx.BlockHeight())
+	if err := h.keeper.SetMemoRef(ctx, ref); err != nil {
+		ctx.Logger().Error("fail to save memo ref", "error", err)
+		return sdk.ErrInternal("fail to save memo ref").Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("register_memo",
+			sdk.NewAttribute("reference", ref.Reference()),
+			sdk.NewAttribute("memo", ref.Memo)),
+	)
+	return sdk.Result{
+		Data:      []byte(ref.Reference()),
+		Events:    ctx.EventManager().Events(),
+		Code:      sdk.CodeOK,
+		Codespace: DefaultCodespace,
+	}
+}
+
+// resolveMemoRef replace a memo reference (REF:<id>) with the memo registered on THORChain,
+// the memo is returned as is when it is not a memo reference, or the reference doesn't exist
+func resolveMemoRef(ctx sdk.Context, keeper Keeper, memo string) string {
+	id, ok := ParseMemoRef(memo)
+	if !ok {
+		return memo
+	}
+	ref, err := keeper.GetMemoRef(ctx, id)
+	if err != nil {
+		ctx.Logger().Error("fail to get memo ref", "id", id, "error", err)
+		return memo
+	}
+	if ref.IsEmpty() {
+		return memo
+	}
+	return ref.Memo
+}
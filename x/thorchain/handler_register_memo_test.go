@@ -0,0 +1,93 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type HandlerRegisterMemoSuite struct{}
+
+var _ = Suite(&HandlerRegisterMemoSuite{})
+
+func (s *HandlerRegisterMemoSuite) TestValidate(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	handler := NewRegisterMemoHandler(k)
+
+	msg := NewMsgRegisterMemo("SWAP:BTC.BTC:"+GetRandomBTCAddress().String(), GetRandomBech32Addr())
+	c.Assert(handler.validate(ctx, msg, constants.SWVersion), IsNil)
+
+	// the destination is a THORName
+	tn := NewTHORName("bob", 100, GetRandomBech32Addr())
+	tn.SetAlias(common.BTCChain, GetRandomBTCAddress())
+	c.Assert(k.SetTHORName(ctx, tn), IsNil)
+	c.Assert(handler.validate(ctx, NewMsgRegisterMemo("SWAP:BTC.BTC:bob", GetRandomBech32Addr()), constants.SWVersion), IsNil)
+	c.Assert(handler.validate(ctx, NewMsgRegisterMemo("SWAP:BTC.BTC:alice", GetRandomBech32Addr()), constants.SWVersion), NotNil)
+
+	// invalid version
+	c.Assert(handler.validate(ctx, msg, semver.Version{}), Equals, errInvalidVersion)
+
+	// invalid msg
+	c.Assert(handler.validate(ctx, MsgRegisterMemo{}, constants.SWVersion), NotNil)
+
+	// memo doesn't parse
+	msg = NewMsgRegisterMemo("BLAH:BTC.BTC", GetRandomBech32Addr())
+	c.Assert(handler.validate(ctx, msg, constants.SWVersion), NotNil)
+
+	// not an inbound memo
+	msg = NewMsgRegisterMemo(NewOutboundMemo(GetRandomTxHash()).String(), GetRandomBech32Addr())
+	c.Assert(handler.validate(ctx, msg, constants.SWVersion), NotNil)
+}
+
+func (s *HandlerRegisterMemoSuite) TestHandle(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	handler := NewRegisterMemoHandler(k)
+
+	addr := GetRandomBech32Addr()
+	swapMemo := "SWAP:BTC.BTC:" + GetRandomBTCAddress().String()
+	msg := NewMsgRegisterMemo(swapMemo, addr)
+
+	// insufficient funds
+	result := handler.Run(ctx, msg, constants.SWVersion, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeInsufficientCoins)
+
+	funds, err := common.NewCoin(common.RuneNative, sdk.NewUint(200*common.One)).Native()
+	c.Assert(err, IsNil)
+	_, err = k.CoinKeeper().AddCoins(ctx, addr, sdk.NewCoins(funds))
+	c.Assert(err, IsNil)
+
+	result = handler.Run(ctx, msg, constants.SWVersion, constAccessor)
+	c.Assert(result.IsOK(), Equals, true, Commentf("%+v", result.Log))
+	c.Assert(string(result.Data), Equals, "REF:1")
+	ref, err := k.GetMemoRef(ctx, 1)
+	c.Assert(err, IsNil)
+	c.Check(ref.Memo, Equals, swapMemo)
+	c.Check(ref.Owner.Equals(addr), Equals, true)
+
+	result = handler.Run(ctx, msg, constants.SWVersion, constAccessor)
+	c.Assert(result.IsOK(), Equals, true, Commentf("%+v", result.Log))
+	c.Assert(string(result.Data), Equals, "REF:2")
+
+	// the transaction fee goes to the reserve
+	c.Check(k.GetRuneBalaceOfModule(ctx, ReserveName).Equal(sdk.NewUint(uint64(2*constAccessor.GetInt64Value(constants.TransactionFee)))), Equals, true)
+
+	// wrong message type
+	result = handler.Run(ctx, NewMsgSetIPAddress("8.8.8.8", addr), constants.SWVersion, constAccessor)
+	c.Assert(result.Code, Equals, errInvalidMessage.Code())
+}
+
+func (s *HandlerRegisterMemoSuite) TestResolveMemoRef(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	swapMemo := "SWAP:BTC.BTC:" + GetRandomBTCAddress().String()
+	c.Assert(k.SetMemoRef(ctx, NewMemoRef(3, swapMemo, GetRandomBech32Addr(), 1)), IsNil)
+
+	c.Check(resolveMemoRef(ctx, k, "REF:3"), Equals, swapMemo)
+	c.Check(resolveMemoRef(ctx, k, "ref:3"), Equals, swapMemo)
+	c.Check(resolveMemoRef(ctx, k, "REF:4"), Equals, "REF:4")
+	c.Check(resolveMemoRef(ctx, k, swapMemo), Equals, swapMemo)
+	c.Check(resolveMemoRef(ctx, KVStoreDummy{}, "REF:3"), Equals, "REF:3")
+}
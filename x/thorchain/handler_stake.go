@@ -117,12 +117,40 @@ func (h StakeHandler) handle(ctx sdk.Context, msg MsgSetStakeData, version semve
 		ctx.Logger().Error("fail to check pool status", "error", err)
 		return sdk.NewError(DefaultCodespace, CodeInvalidPoolStatus, err.Error())
 	}
+	runeAmount := msg.RuneAmount
+	assetAmount := msg.AssetAmount
+	var pending PendingLiquidity
+	paired := false
+	if !msg.Asset.Chain.IsBNB() {
+		// a stake from two chains arrives as two txs, the RUNE leg is held in a pending liquidity record until the
+		// asset leg arrives, then both are staked together. An asset leg no RUNE leg is waiting for is staked on its own
+		pending, err = h.getPendingLiquidity(ctx, msg)
+		if err != nil {
+			return sdk.ErrInternal(fmt.Errorf("fail to get pending liquidity: %w", err).Error())
+		}
+		if !pending.IsEmpty() || isRuneLeg(msg) {
+			if pending.IsEmpty() {
+				pending.ExpireBlockHeight = ctx.BlockHeight() + constAccessor.GetInt64Value(constants.PendingLiquidityExpiry)
+			}
+			pending.Add(msg.RuneAmount, msg.AssetAmount, msg.Tx)
+			if !pending.IsComplete() {
+				if err := h.keeper.SetPendingLiquidity(ctx, pending); err != nil {
+					return sdk.ErrInternal(fmt.Errorf("fail to save pending liquidity: %w", err).Error())
+				}
+				ctx.Logger().Info("stake is pending until the other side arrives", "pending", pending)
+				return nil
+			}
+			runeAmount = pending.PendingRune
+			assetAmount = pending.PendingAsset
+			paired = true
+		}
+	}
 	stakeUnits, err := stake(
 		ctx,
 		h.keeper,
 		msg.Asset,
-		msg.RuneAmount,
-		msg.AssetAmount,
+		runeAmount,
+		assetAmount,
 		msg.RuneAddress,
 		msg.AssetAddress,
 		msg.Tx.ID,
@@ -131,6 +159,17 @@ func (h StakeHandler) handle(ctx sdk.Context, msg MsgSetStakeData, version semve
 	if err != nil {
 		return sdk.ErrUnknownRequest(fmt.Errorf("fail to process stake request: %w", err).Error())
 	}
+	if paired {
+		h.keeper.RemovePendingLiquidity(ctx, pending)
+		eventMgr, err := h.versionedEventManager.GetEventManager(ctx, version)
+		if err != nil {
+			return errFailGetEventManager
+		}
+		if err := emitPendingLiquidityStakeEvents(ctx, h.keeper, eventMgr, pending, stakeUnits); err != nil {
+			return sdk.ErrInternal(fmt.Errorf("fail to save stake event: %w", err).Error())
+		}
+		return nil
+	}
 
 	if err := h.processStakeEvent(ctx, version, msg, stakeUnits); err != nil {
 		return sdk.ErrInternal(fmt.Errorf("fail to save stake event: %w", err).Error())
@@ -139,6 +178,26 @@ func (h StakeHandler) handle(ctx sdk.Context, msg MsgSetStakeData, version semve
 	return nil
 }
 
+// isRuneLeg return true when the given stake only carries RUNE, ie it is the RUNE leg of a stake from two chains
+func isRuneLeg(msg MsgSetStakeData) bool {
+	return !msg.RuneAmount.IsZero() && msg.AssetAmount.IsZero()
+}
+
+// getPendingLiquidity find the pending liquidity the given stake belongs to. A RUNE leg sent without an asset
+// address (ie STAKE:BTC.BTC) is recorded with the RUNE address as asset address, the asset leg of the same RUNE
+// address pairs with it when no RUNE leg names its asset address
+func (h StakeHandler) getPendingLiquidity(ctx sdk.Context, msg MsgSetStakeData) (PendingLiquidity, error) {
+	pending, err := h.keeper.GetPendingLiquidity(ctx, msg.Asset, msg.RuneAddress, msg.AssetAddress)
+	if err != nil || !pending.IsEmpty() || isRuneLeg(msg) {
+		return pending, err
+	}
+	runeLeg, err := h.keeper.GetPendingLiquidity(ctx, msg.Asset, msg.RuneAddress, msg.RuneAddress)
+	if err != nil || runeLeg.IsEmpty() {
+		return pending, err
+	}
+	return runeLeg, nil
+}
+
 func (h StakeHandler) processStakeEvent(ctx sdk.Context, version semver.Version, msg MsgSetStakeData, stakeUnits sdk.Uint) error {
 	eventMgr, err := h.versionedEventManager.GetEventManager(ctx, version)
 	if err != nil {
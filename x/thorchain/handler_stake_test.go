@@ -318,3 +318,159 @@ func (HandlerStakeSuite) TestHandlerStakeFailScenario(c *C) {
 		c.Assert(result.Code, Equals, tc.expectedResult, Commentf(tc.name))
 	}
 }
+
+func (HandlerStakeSuite) TestStakeHandlerCrossChain(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	activeNodeAccount := GetRandomNodeAccount(NodeActive)
+	activeNodeAccount.Bond = sdk.NewUint(1000000 * common.One)
+	c.Assert(k.SetNodeAccount(ctx, activeNodeAccount), IsNil)
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.Status = PoolEnabled
+	pool.BalanceRune = sdk.NewUint(100 * common.One)
+	pool.BalanceAsset = sdk.NewUint(100 * common.One)
+	pool.PoolUnits = sdk.NewUint(100 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	stakeHandler := NewStakeHandler(k, NewVersionedEventMgr())
+	runeAddr := GetRandomBNBAddress()
+	btcAddr := GetRandomBTCAddress()
+
+	// the rune side is held until the btc side arrives
+	runeTx := common.NewTx(
+		GetRandomTxHash(),
+		runeAddr,
+		GetRandomBNBAddress(),
+		common.Coins{common.NewCoin(common.RuneAsset(), sdk.NewUint(100*common.One))},
+		BNBGasFeeSingleton,
+		"stake:BTC.BTC:"+btcAddr.String(),
+	)
+	msg := NewMsgSetStakeData(runeTx, common.BTCAsset, sdk.NewUint(100*common.One), sdk.ZeroUint(), runeAddr, btcAddr, activeNodeAccount.NodeAddress)
+	result := stakeHandler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%s", result.Log))
+	pending, err := k.GetPendingLiquidity(ctx, common.BTCAsset, runeAddr, btcAddr)
+	c.Assert(err, IsNil)
+	c.Check(pending.PendingRune.Equal(sdk.NewUint(100*common.One)), Equals, true)
+	c.Check(pending.ExpireBlockHeight, Equals, ctx.BlockHeight()+constAccessor.GetInt64Value(constants.PendingLiquidityExpiry))
+	pool, err = k.GetPool(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceRune.Equal(sdk.NewUint(100*common.One)), Equals, true)
+
+	// the btc side of another address pair doesn't complete it, it is staked on its own straight away
+	otherRuneAddr := GetRandomBNBAddress()
+	btcTx := common.NewTx(
+		GetRandomTxHash(),
+		GetRandomBTCAddress(),
+		GetRandomBTCAddress(),
+		common.Coins{common.NewCoin(common.BTCAsset, sdk.NewUint(100*common.One))},
+		BNBGasFeeSingleton,
+		"stake:BTC.BTC:"+otherRuneAddr.String(),
+	)
+	msg = NewMsgSetStakeData(btcTx, common.BTCAsset, sdk.ZeroUint(), sdk.NewUint(100*common.One), otherRuneAddr, btcTx.FromAddress, activeNodeAccount.NodeAddress)
+	result = stakeHandler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%s", result.Log))
+	pool, err = k.GetPool(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceRune.Equal(sdk.NewUint(100*common.One)), Equals, true)
+	c.Check(pool.BalanceAsset.Equal(sdk.NewUint(200*common.One)), Equals, true)
+	pending, err = k.GetPendingLiquidity(ctx, common.BTCAsset, runeAddr, btcAddr)
+	c.Assert(err, IsNil)
+	c.Check(pending.PendingRune.Equal(sdk.NewUint(100*common.One)), Equals, true)
+
+	// the btc side completes the stake
+	btcTx.ID = GetRandomTxHash()
+	btcTx.FromAddress = btcAddr
+	btcTx.Memo = "stake:BTC.BTC:" + runeAddr.String()
+	msg = NewMsgSetStakeData(btcTx, common.BTCAsset, sdk.ZeroUint(), sdk.NewUint(100*common.One), runeAddr, btcAddr, activeNodeAccount.NodeAddress)
+	result = stakeHandler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%s", result.Log))
+	pool, err = k.GetPool(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceRune.Equal(sdk.NewUint(200*common.One)), Equals, true)
+	c.Check(pool.BalanceAsset.Equal(sdk.NewUint(300*common.One)), Equals, true)
+	su, err := k.GetStaker(ctx, common.BTCAsset, runeAddr)
+	c.Assert(err, IsNil)
+	c.Check(su.Units.IsZero(), Equals, false)
+	c.Check(su.AssetAddress.Equals(btcAddr), Equals, true)
+	pending, err = k.GetPendingLiquidity(ctx, common.BTCAsset, runeAddr, btcAddr)
+	c.Assert(err, IsNil)
+	c.Check(pending.IsEmpty(), Equals, true)
+}
+
+func (HandlerStakeSuite) TestStakeHandlerCrossChainWithoutAssetAddress(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	activeNodeAccount := GetRandomNodeAccount(NodeActive)
+	activeNodeAccount.Bond = sdk.NewUint(1000000 * common.One)
+	c.Assert(k.SetNodeAccount(ctx, activeNodeAccount), IsNil)
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.Status = PoolEnabled
+	pool.BalanceRune = sdk.NewUint(100 * common.One)
+	pool.BalanceAsset = sdk.NewUint(100 * common.One)
+	pool.PoolUnits = sdk.NewUint(100 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	stakeHandler := NewStakeHandler(k, NewVersionedEventMgr())
+
+	runeLeg := func(runeAddr common.Address) MsgSetStakeData {
+		tx := common.NewTx(
+			GetRandomTxHash(),
+			runeAddr,
+			GetRandomBNBAddress(),
+			common.Coins{common.NewCoin(common.RuneAsset(), sdk.NewUint(100*common.One))},
+			BNBGasFeeSingleton,
+			"stake:BTC.BTC",
+		)
+		// no asset address in the memo, the asset address is the RUNE address
+		return NewMsgSetStakeData(tx, common.BTCAsset, sdk.NewUint(100*common.One), sdk.ZeroUint(), runeAddr, runeAddr, activeNodeAccount.NodeAddress)
+	}
+	assetLeg := func(runeAddr, btcAddr common.Address) MsgSetStakeData {
+		tx := common.NewTx(
+			GetRandomTxHash(),
+			btcAddr,
+			GetRandomBTCAddress(),
+			common.Coins{common.NewCoin(common.BTCAsset, sdk.NewUint(100*common.One))},
+			common.Gas{common.NewCoin(common.BTCAsset, sdk.NewUint(10000))},
+			"stake:BTC.BTC:"+runeAddr.String(),
+		)
+		return NewMsgSetStakeData(tx, common.BTCAsset, sdk.ZeroUint(), sdk.NewUint(100*common.One), runeAddr, btcAddr, activeNodeAccount.NodeAddress)
+	}
+	checkPool := func(runeAmt, assetAmt uint64) {
+		pool, err := k.GetPool(ctx, common.BTCAsset)
+		c.Assert(err, IsNil)
+		c.Check(pool.BalanceRune.Equal(sdk.NewUint(runeAmt*common.One)), Equals, true, Commentf("%s", pool.BalanceRune))
+		c.Check(pool.BalanceAsset.Equal(sdk.NewUint(assetAmt*common.One)), Equals, true, Commentf("%s", pool.BalanceAsset))
+	}
+
+	// the rune side without asset address first, the btc side of the same rune address completes it
+	runeAddr := GetRandomBNBAddress()
+	btcAddr := GetRandomBTCAddress()
+	result := stakeHandler.Run(ctx, runeLeg(runeAddr), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%s", result.Log))
+	checkPool(100, 100)
+	result = stakeHandler.Run(ctx, assetLeg(runeAddr, btcAddr), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%s", result.Log))
+	checkPool(200, 200)
+	su, err := k.GetStaker(ctx, common.BTCAsset, runeAddr)
+	c.Assert(err, IsNil)
+	c.Check(su.Units.IsZero(), Equals, false)
+	c.Check(su.AssetAddress.Equals(btcAddr), Equals, true)
+	pending, err := k.GetPendingLiquidity(ctx, common.BTCAsset, runeAddr, runeAddr)
+	c.Assert(err, IsNil)
+	c.Check(pending.IsEmpty(), Equals, true)
+
+	// an asset leg no rune side is waiting for is an asymmetric stake, it doesn't wait
+	runeAddr = GetRandomBNBAddress()
+	btcAddr = GetRandomBTCAddress()
+	result = stakeHandler.Run(ctx, assetLeg(runeAddr, btcAddr), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%s", result.Log))
+	checkPool(200, 300)
+	su, err = k.GetStaker(ctx, common.BTCAsset, runeAddr)
+	c.Assert(err, IsNil)
+	c.Check(su.Units.IsZero(), Equals, false)
+	pending, err = k.GetPendingLiquidity(ctx, common.BTCAsset, runeAddr, btcAddr)
+	c.Assert(err, IsNil)
+	c.Check(pending.IsEmpty(), Equals, true)
+}
@@ -1,6 +1,7 @@
 package thorchain
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/blang/semver"
@@ -68,6 +69,110 @@ func (h SwapHandler) handle(ctx sdk.Context, msg MsgSwap, version semver.Version
 }
 
 func (h SwapHandler) handleV1(ctx sdk.Context, msg MsgSwap, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	if msg.AffiliateAddress.IsEmpty() || msg.AffiliateBasisPoints.IsZero() {
+		return h.swapAndSend(ctx, msg, version, constAccessor)
+	}
+	// the affiliate fee and the swap either both go through or neither does, if the swap fails the whole input is refunded
+	cacheCtx, commit := ctx.CacheContext()
+	affCoin, err := h.payAffiliate(cacheCtx, msg, version, constAccessor)
+	if err != nil {
+		// the affiliate fee is skipped rather than failing the swap, e.g. it is too small to pay for the outbound
+		ctx.Logger().Error("fail to pay affiliate fee", "error", err)
+		return h.swapAndSend(ctx, msg, version, constAccessor)
+	}
+	swapMsg := msg
+	swapMsg.Tx.Coins = common.Coins{
+		common.NewCoin(msg.Tx.Coins[0].Asset, common.SafeSub(msg.Tx.Coins[0].Amount, affCoin.Amount)),
+	}
+	result := h.swapAndSend(cacheCtx, swapMsg, version, constAccessor)
+	if !result.IsOK() {
+		return result
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return result
+}
+
+// payAffiliate take the affiliate fee out of the swap input, swap it to RUNE and pay it to the affiliate address,
+// it returns the coin taken out of the swap input. An affiliate with a THORChain address is credited native RUNE,
+// otherwise the RUNE is sent out, and a fee too small to pay for the outbound is an error
+func (h SwapHandler) payAffiliate(ctx sdk.Context, msg MsgSwap, version semver.Version, constAccessor constants.ConstantValues) (common.Coin, error) {
+	source := msg.Tx.Coins[0]
+	affCoin := common.NewCoin(source.Asset, source.Amount.Mul(msg.AffiliateBasisPoints).QuoUint64(10_000))
+	if affCoin.Amount.IsZero() {
+		return affCoin, errors.New("affiliate fee is zero")
+	}
+	runeAmt := affCoin.Amount
+	if !source.Asset.IsRune() {
+		affMsg := msg
+		affMsg.Tx.Coins = common.Coins{affCoin}
+		affMsg.TargetAsset = common.RuneAsset()
+		affMsg.Destination = msg.AffiliateAddress
+		affMsg.TradeTarget = sdk.ZeroUint()
+		amt, err := h.swap(ctx, affMsg, version, constAccessor)
+		if err != nil {
+			return affCoin, fmt.Errorf("fail to swap affiliate fee to RUNE: %w", err)
+		}
+		runeAmt = amt
+	}
+	if msg.AffiliateAddress.IsChain(common.THORChain) && !common.RuneAsset().Chain.Equals(common.THORChain) {
+		if err := h.creditNativeRune(ctx, msg.AffiliateAddress, runeAmt); err != nil {
+			return affCoin, fmt.Errorf("fail to credit affiliate fee: %w", err)
+		}
+	} else {
+		txOutStore, err := h.versionedTxOutStore.GetTxOutStore(ctx, h.keeper, version)
+		if err != nil {
+			return affCoin, fmt.Errorf("fail to get txout store: %w", err)
+		}
+		toi := &TxOutItem{
+			Chain:     common.RuneAsset().Chain,
+			InHash:    msg.Tx.ID,
+			ToAddress: msg.AffiliateAddress,
+			Coin:      common.NewCoin(common.RuneAsset(), runeAmt),
+		}
+		ok, err := txOutStore.TryAddTxOutItem(ctx, toi)
+		if err != nil {
+			return affCoin, fmt.Errorf("fail to add affiliate outbound tx: %w", err)
+		}
+		if !ok {
+			return affCoin, errors.New("prepare affiliate outbound tx not successful")
+		}
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("affiliate_fee",
+			sdk.NewAttribute("id", msg.Tx.ID.String()),
+			sdk.NewAttribute("address", msg.AffiliateAddress.String()),
+			sdk.NewAttribute("basis_points", msg.AffiliateBasisPoints.String()),
+			sdk.NewAttribute("coin", affCoin.String()),
+			sdk.NewAttribute("rune", runeAmt.String())),
+	)
+	return affCoin, nil
+}
+
+// creditNativeRune mint the given amount of native RUNE to the given THORChain address, the same way BEP2 RUNE is
+// switched to native RUNE, the BEP2 RUNE backing it stays in the vault
+func (h SwapHandler) creditNativeRune(ctx sdk.Context, addr common.Address, amt sdk.Uint) error {
+	coin, err := common.NewCoin(common.RuneNative, amt).Native()
+	if err != nil {
+		return fmt.Errorf("fail to get native coin: %w", err)
+	}
+	accAddr, err := sdk.AccAddressFromBech32(addr.String())
+	if err != nil {
+		return fmt.Errorf("fail to parse thor address: %w", err)
+	}
+	vaultData, err := h.keeper.GetVaultData(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to get vault data: %w", err)
+	}
+	if _, err := h.keeper.CoinKeeper().AddCoins(ctx, accAddr, sdk.NewCoins(coin)); err != nil {
+		return fmt.Errorf("fail to mint native rune coins: %w", err)
+	}
+	vaultData.TotalBEP2Rune = vaultData.TotalBEP2Rune.Add(amt)
+	return h.keeper.SetVaultData(ctx, vaultData)
+}
+
+// swap run the swap of the given msg, emit the swap events and record the liquidity fees, it returns the emitted amount of the target asset
+func (h SwapHandler) swap(ctx sdk.Context, msg MsgSwap, version semver.Version, constAccessor constants.ConstantValues) (sdk.Uint, sdk.Error) {
 	transactionFee := constAccessor.GetInt64Value(constants.TransactionFee)
 	amount, events, swapErr := swap(
 		ctx,
@@ -79,21 +184,30 @@ func (h SwapHandler) handleV1(ctx sdk.Context, msg MsgSwap, version semver.Versi
 		sdk.NewUint(uint64(transactionFee)))
 	if swapErr != nil {
 		ctx.Logger().Error("fail to process swap message", "error", swapErr)
-		return swapErr.Result()
+		return sdk.ZeroUint(), swapErr
 	}
 	eventMgr, err := h.versionedEventManager.GetEventManager(ctx, version)
 	if err != nil {
 		ctx.Logger().Error("fail to get event manager", "error", err)
-		return errFailGetEventManager.Result()
+		return sdk.ZeroUint(), errFailGetEventManager
 	}
 	for _, evt := range events {
 		if err := eventMgr.EmitSwapEvent(ctx, h.keeper, evt); err != nil {
 			ctx.Logger().Error("fail to emit swap event", "error", err)
 		}
 		if err := h.keeper.AddToLiquidityFees(ctx, evt.Pool, evt.LiquidityFeeInRune); err != nil {
-			return sdk.ErrInternal(err.Error()).Result()
+			return sdk.ZeroUint(), sdk.ErrInternal(err.Error())
 		}
 	}
+	return amount, nil
+}
+
+// swapAndSend swap the input of the given msg and send the target asset to the destination
+func (h SwapHandler) swapAndSend(ctx sdk.Context, msg MsgSwap, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	amount, swapErr := h.swap(ctx, msg, version, constAccessor)
+	if swapErr != nil {
+		return swapErr.Result()
+	}
 
 	res, err := h.keeper.Cdc().MarshalBinaryLengthPrefixed(
 		struct {
@@ -16,6 +16,10 @@ type HandlerSwapSuite struct{}
 
 var _ = Suite(&HandlerSwapSuite{})
 
+func (s *HandlerSwapSuite) SetUpSuite(c *C) {
+	SetupConfigForTest()
+}
+
 func (s *HandlerSwapSuite) TestValidate(c *C) {
 	ctx, _ := setupKeeperForTest(c)
 
@@ -41,7 +45,7 @@ func (s *HandlerSwapSuite) TestValidate(c *C) {
 		BNBGasFeeSingleton,
 		"",
 	)
-	msg := NewMsgSwap(tx, common.BNBAsset, signerBNBAddr, sdk.ZeroUint(), observerAddr)
+	msg := NewMsgSwap(tx, common.BNBAsset, signerBNBAddr, sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), observerAddr)
 	err := handler.validate(ctx, msg, ver)
 	c.Assert(err, IsNil)
 
@@ -55,7 +59,7 @@ func (s *HandlerSwapSuite) TestValidate(c *C) {
 	c.Assert(err, NotNil)
 
 	// not signed observer
-	msg = NewMsgSwap(tx, common.BNBAsset, signerBNBAddr, sdk.ZeroUint(), GetRandomBech32Addr())
+	msg = NewMsgSwap(tx, common.BNBAsset, signerBNBAddr, sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr())
 	err = handler.validate(ctx, msg, ver)
 	c.Assert(err, Equals, notAuthorized)
 }
@@ -136,7 +140,7 @@ func (s *HandlerSwapSuite) TestHandle(c *C) {
 		"",
 	)
 	keeper.clearEvent()
-	msg := NewMsgSwap(tx, common.BNBAsset, signerBNBAddr, sdk.ZeroUint(), observerAddr)
+	msg := NewMsgSwap(tx, common.BNBAsset, signerBNBAddr, sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), observerAddr)
 	res := handler.handle(ctx, msg, ver, constAccessor)
 	c.Assert(res.Code, Equals, CodeSwapFailPoolNotExist)
 	c.Assert(keeper.event, IsNil)
@@ -159,7 +163,7 @@ func (s *HandlerSwapSuite) TestHandle(c *C) {
 		"",
 	)
 	keeper.clearEvent()
-	msgSwapPriceProtection := NewMsgSwap(tx, common.BNBAsset, signerBNBAddr, sdk.NewUint(2*common.One), observerAddr)
+	msgSwapPriceProtection := NewMsgSwap(tx, common.BNBAsset, signerBNBAddr, sdk.NewUint(2*common.One), common.NoAddress, sdk.ZeroUint(), observerAddr)
 	res1 := handler.handle(ctx, msgSwapPriceProtection, ver, constAccessor)
 	c.Assert(res1.IsOK(), Equals, false)
 	c.Assert(res1.Code, Equals, CodeSwapFailTradeTarget)
@@ -285,3 +289,118 @@ func (s *HandlerSwapSuite) TestDoubleSwap(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(items, HasLen, 0)
 }
+
+func (s *HandlerSwapSuite) TestAffiliateFee(c *C) {
+	ctx, _ := setupKeeperForTest(c)
+	keeper := &TestSwapHandleKeeper{
+		pools:             make(map[common.Asset]Pool),
+		activeNodeAccount: GetRandomNodeAccount(NodeActive),
+	}
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	handler := NewSwapHandler(keeper, versionedTxOutStoreDummy, NewVersionedEventMgr())
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	versionedTxOutStoreDummy.txoutStore.NewBlock(1, constAccessor)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceAsset = sdk.NewUint(1000 * common.One)
+	pool.BalanceRune = sdk.NewUint(1000 * common.One)
+	c.Assert(keeper.SetPool(ctx, pool), IsNil)
+
+	signerBNBAddr := GetRandomBNBAddress()
+	affAddr := GetRandomRUNEAddress()
+	observerAddr := keeper.activeNodeAccount.NodeAddress
+
+	// RUNE input, the affiliate fee is paid without a swap
+	tx := common.NewTx(GetRandomTxHash(), signerBNBAddr, signerBNBAddr,
+		common.Coins{common.NewCoin(common.RuneAsset(), sdk.NewUint(100*common.One))},
+		BNBGasFeeSingleton,
+		"",
+	)
+	msg := NewMsgSwap(tx, common.BNBAsset, signerBNBAddr, sdk.ZeroUint(), affAddr, sdk.NewUint(500), observerAddr)
+	res := handler.handle(ctx, msg, ver, constAccessor)
+	c.Assert(res.IsOK(), Equals, true, Commentf("%s", res.Log))
+	items, err := versionedTxOutStoreDummy.txoutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	c.Check(items[0].ToAddress.Equals(affAddr), Equals, true)
+	c.Check(items[0].Coin.Equals(common.NewCoin(common.RuneAsset(), sdk.NewUint(5*common.One))), Equals, true)
+	c.Check(items[1].ToAddress.Equals(signerBNBAddr), Equals, true)
+	c.Check(keeper.pools[common.BNBAsset].BalanceRune.Equal(sdk.NewUint(1095*common.One)), Equals, true)
+
+	// asset input, the affiliate fee is swapped to RUNE first
+	versionedTxOutStoreDummy = NewVersionedTxOutStoreDummy()
+	versionedTxOutStoreDummy.txoutStore.NewBlock(1, constAccessor)
+	handler = NewSwapHandler(keeper, versionedTxOutStoreDummy, NewVersionedEventMgr())
+	tx = common.NewTx(GetRandomTxHash(), signerBNBAddr, signerBNBAddr,
+		common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(100*common.One))},
+		BNBGasFeeSingleton,
+		"",
+	)
+	msg = NewMsgSwap(tx, common.RuneAsset(), signerBNBAddr, sdk.ZeroUint(), affAddr, sdk.NewUint(500), observerAddr)
+	balanceAsset := keeper.pools[common.BNBAsset].BalanceAsset
+	res = handler.handle(ctx, msg, ver, constAccessor)
+	c.Assert(res.IsOK(), Equals, true, Commentf("%s", res.Log))
+	items, err = versionedTxOutStoreDummy.txoutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	c.Check(items[0].ToAddress.Equals(affAddr), Equals, true)
+	c.Check(items[0].Coin.Asset.Equals(common.RuneAsset()), Equals, true)
+	c.Check(items[0].Coin.Amount.IsZero(), Equals, false)
+	c.Check(items[1].ToAddress.Equals(signerBNBAddr), Equals, true)
+	c.Check(keeper.pools[common.BNBAsset].BalanceAsset.Equal(balanceAsset.Add(sdk.NewUint(100*common.One))), Equals, true)
+
+	// affiliate fee too small to pay the transaction fee, the whole input is swapped
+	versionedTxOutStoreDummy = NewVersionedTxOutStoreDummy()
+	versionedTxOutStoreDummy.txoutStore.NewBlock(1, constAccessor)
+	handler = NewSwapHandler(keeper, versionedTxOutStoreDummy, NewVersionedEventMgr())
+	msg = NewMsgSwap(tx, common.RuneAsset(), signerBNBAddr, sdk.ZeroUint(), affAddr, sdk.NewUint(10), observerAddr)
+	res = handler.handle(ctx, msg, ver, constAccessor)
+	c.Assert(res.IsOK(), Equals, true, Commentf("%s", res.Log))
+	items, err = versionedTxOutStoreDummy.txoutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].ToAddress.Equals(signerBNBAddr), Equals, true)
+}
+
+func (s *HandlerSwapSuite) TestAffiliateFeeTHORAddress(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	handler := NewSwapHandler(k, versionedTxOutStoreDummy, NewVersionedEventMgr())
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	versionedTxOutStoreDummy.txoutStore.NewBlock(1, constAccessor)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceAsset = sdk.NewUint(1000 * common.One)
+	pool.BalanceRune = sdk.NewUint(1000 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	signerBNBAddr := GetRandomBNBAddress()
+	affAddr := GetRandomTHORAddress()
+	affAccAddr, err := sdk.AccAddressFromBech32(affAddr.String())
+	c.Assert(err, IsNil)
+
+	// a THORChain affiliate is credited native RUNE, even a cut too small to pay for an outbound
+	tx := common.NewTx(GetRandomTxHash(), signerBNBAddr, signerBNBAddr,
+		common.Coins{common.NewCoin(common.RuneAsset(), sdk.NewUint(100*common.One))},
+		BNBGasFeeSingleton,
+		"",
+	)
+	msg := NewMsgSwap(tx, common.BNBAsset, signerBNBAddr, sdk.ZeroUint(), affAddr, sdk.NewUint(1), GetRandomBech32Addr())
+	res := handler.handle(ctx, msg, ver, constAccessor)
+	c.Assert(res.IsOK(), Equals, true, Commentf("%s", res.Log))
+	items, err := versionedTxOutStoreDummy.txoutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].ToAddress.Equals(signerBNBAddr), Equals, true)
+	c.Check(k.CoinKeeper().GetCoins(ctx, affAccAddr).AmountOf(common.RuneNative.Native()).Equal(sdk.NewInt(common.One/100)), Equals, true)
+	vaultData, err := k.GetVaultData(ctx)
+	c.Assert(err, IsNil)
+	c.Check(vaultData.TotalBEP2Rune.Equal(sdk.NewUint(common.One/100)), Equals, true)
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceRune.Equal(sdk.NewUint(1099_99000000)), Equals, true)
+}
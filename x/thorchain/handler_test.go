@@ -366,7 +366,7 @@ func (HandlerSuite) TestGetMsgStakeFromMemo(c *C) {
 		common.EmptyPubKey,
 	)
 
-	msg, err := getMsgStakeFromMemo(w.ctx, stakeMemo, txin, GetRandomBech32Addr())
+	msg, err := getMsgStakeFromMemo(w.ctx, w.keeper, stakeMemo, txin, GetRandomBech32Addr())
 	c.Assert(msg, IsNil)
 	c.Assert(err, NotNil)
 
@@ -377,7 +377,7 @@ func (HandlerSuite) TestGetMsgStakeFromMemo(c *C) {
 	}
 
 	// stake only rune should be fine
-	msg1, err1 := getMsgStakeFromMemo(w.ctx, stakeMemo, txin, GetRandomBech32Addr())
+	msg1, err1 := getMsgStakeFromMemo(w.ctx, w.keeper, stakeMemo, txin, GetRandomBech32Addr())
 	c.Assert(msg1, NotNil)
 	c.Assert(err1, IsNil)
 
@@ -389,7 +389,7 @@ func (HandlerSuite) TestGetMsgStakeFromMemo(c *C) {
 	}
 
 	// stake only token(BNB) should be fine
-	msg2, err2 := getMsgStakeFromMemo(w.ctx, stakeMemo, txin, GetRandomBech32Addr())
+	msg2, err2 := getMsgStakeFromMemo(w.ctx, w.keeper, stakeMemo, txin, GetRandomBech32Addr())
 	c.Assert(msg2, NotNil)
 	c.Assert(err2, IsNil)
 
@@ -402,7 +402,7 @@ func (HandlerSuite) TestGetMsgStakeFromMemo(c *C) {
 	}
 
 	// stake only token should be fine
-	msg3, err3 := getMsgStakeFromMemo(w.ctx, stakeMemo, txin, GetRandomBech32Addr())
+	msg3, err3 := getMsgStakeFromMemo(w.ctx, w.keeper, stakeMemo, txin, GetRandomBech32Addr())
 	c.Assert(msg3, IsNil)
 	c.Assert(err3, NotNil)
 
@@ -416,7 +416,7 @@ func (HandlerSuite) TestGetMsgStakeFromMemo(c *C) {
 
 	lokiStakeMemo, err := ParseMemo("stake:BNB.LOKI")
 	c.Assert(err, IsNil)
-	msg4, err4 := getMsgStakeFromMemo(w.ctx, lokiStakeMemo.(StakeMemo), txin, GetRandomBech32Addr())
+	msg4, err4 := getMsgStakeFromMemo(w.ctx, w.keeper, lokiStakeMemo.(StakeMemo), txin, GetRandomBech32Addr())
 	c.Assert(err4, IsNil)
 	c.Assert(msg4, NotNil)
 	msgStake := msg4.(MsgSetStakeData)
@@ -424,3 +424,52 @@ func (HandlerSuite) TestGetMsgStakeFromMemo(c *C) {
 	c.Assert(msgStake.RuneAddress, Equals, txin.Tx.FromAddress)
 	c.Assert(msgStake.AssetAddress, Equals, txin.Tx.FromAddress)
 }
+
+func (HandlerSuite) TestGetMsgStakeFromMemoWithTHORName(c *C) {
+	w := getHandlerTestWrapper(c, 1, true, false)
+	bnbAddr := GetRandomBNBAddress()
+	btcAddr := GetRandomBTCAddress()
+	tn := NewTHORName("bob", 100, GetRandomBech32Addr())
+	tn.SetAlias(common.BNBChain, bnbAddr)
+	tn.SetAlias(common.BTCChain, btcAddr)
+	c.Assert(w.keeper.SetTHORName(w.ctx, tn), IsNil)
+	m, err := ParseMemoWithTHORNames(w.ctx, w.keeper, "STAKE:BTC.BTC:bob")
+	c.Assert(err, IsNil)
+	stakeMemo := m.(StakeMemo)
+
+	// on the asset side, the THORName stands for the RUNE address of the staker
+	assetAddr := GetRandomBTCAddress()
+	txin := NewObservedTx(common.Tx{
+		ID:          GetRandomTxHash(),
+		Chain:       common.BTCChain,
+		Coins:       common.Coins{common.NewCoin(common.BTCAsset, sdk.NewUint(common.One))},
+		Memo:        "STAKE:BTC.BTC:bob",
+		FromAddress: assetAddr,
+		ToAddress:   GetRandomBTCAddress(),
+		Gas:         common.Gas{common.NewCoin(common.BTCAsset, sdk.NewUint(10000))},
+	}, 1024, common.EmptyPubKey)
+	msg, err := getMsgStakeFromMemo(w.ctx, w.keeper, stakeMemo, txin, GetRandomBech32Addr())
+	c.Assert(err, IsNil)
+	stakeMsg := msg.(MsgSetStakeData)
+	c.Check(stakeMsg.RuneAddress.Equals(bnbAddr), Equals, true)
+	c.Check(stakeMsg.AssetAddress.Equals(assetAddr), Equals, true)
+
+	// on the RUNE side, it stands for the asset address
+	runeAddr := GetRandomBNBAddress()
+	txin.Tx.Chain = common.BNBChain
+	txin.Tx.FromAddress = runeAddr
+	txin.Tx.ToAddress = GetRandomBNBAddress()
+	txin.Tx.Coins = common.Coins{common.NewCoin(common.RuneAsset(), sdk.NewUint(common.One))}
+	txin.Tx.Gas = BNBGasFeeSingleton
+	msg, err = getMsgStakeFromMemo(w.ctx, w.keeper, stakeMemo, txin, GetRandomBech32Addr())
+	c.Assert(err, IsNil)
+	stakeMsg = msg.(MsgSetStakeData)
+	c.Check(stakeMsg.RuneAddress.Equals(runeAddr), Equals, true)
+	c.Check(stakeMsg.AssetAddress.Equals(btcAddr), Equals, true)
+
+	// unknown THORName
+	m, err = ParseMemoWithTHORNames(w.ctx, w.keeper, "STAKE:BTC.BTC:alice")
+	c.Assert(err, IsNil)
+	_, err = getMsgStakeFromMemo(w.ctx, w.keeper, m.(StakeMemo), txin, GetRandomBech32Addr())
+	c.Check(err, NotNil)
+}
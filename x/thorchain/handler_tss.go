@@ -109,10 +109,13 @@ func (h TssHandler) handleV1(ctx sdk.Context, msg MsgTssPool, version semver.Ver
 		h.keeper.SetTssVoter(ctx, voter)
 		if msg.IsSuccess() {
 			vaultType := YggdrasilVault
+			vaultStatus := ActiveVault
 			if msg.KeygenType == AsgardKeygen {
 				vaultType = AsgardVault
+				// asgard vault only become active when all the asgard vaults of the same churn are created
+				vaultStatus = InitVault
 			}
-			vault := NewVault(ctx.BlockHeight(), ActiveVault, vaultType, voter.PoolPubKey, voter.ConsensusChains())
+			vault := NewVault(ctx.BlockHeight(), vaultStatus, vaultType, voter.PoolPubKey, voter.ConsensusChains())
 			vault.Membership = voter.PubKeys
 			if err := h.keeper.SetVault(ctx, vault); err != nil {
 				ctx.Logger().Error("fail to save vault", "error", err)
@@ -123,10 +126,23 @@ func (h TssHandler) handleV1(ctx sdk.Context, msg MsgTssPool, version semver.Ver
 				ctx.Logger().Error("fail to get a valid vault manager", "error", err)
 				return sdk.ErrInternal(err.Error()).Result()
 			}
-			if err := vaultMgr.RotateVault(ctx, vault); err != nil {
+			if vault.IsAsgard() {
+				if err := h.rotateAsgardVaults(ctx, msg.Height, vaultMgr); err != nil {
+					return sdk.ErrInternal(err.Error()).Result()
+				}
+			} else if err := vaultMgr.RotateVault(ctx, vault); err != nil {
 				return sdk.ErrInternal(err.Error()).Result()
 			}
 		} else {
+			// the asgard vaults of a churn are rotated in together, so when
+			// one of them fails the others can't be used either, the whole
+			// keygen set is retried on the next churn
+			if msg.KeygenType == AsgardKeygen {
+				if err := h.retireInitAsgardVaults(ctx, msg.Height); err != nil {
+					ctx.Logger().Error("fail to retire init asgard vaults", "error", err)
+					return sdk.ErrInternal(err.Error()).Result()
+				}
+			}
 			// if a node fail to join the keygen, thus hold off the network from churning then it will be slashed accordingly
 			constAccessor := constants.GetConstantValues(version)
 			slashPoints := constAccessor.GetInt64Value(constants.FailKeygenSlashPoints)
@@ -144,7 +160,7 @@ func (h TssHandler) handleV1(ctx sdk.Context, msg MsgTssPool, version semver.Ver
 				}
 				if na.Status == NodeActive {
 					// 720 blocks per hour
-					if err := h.keeper.IncNodeAccountSlashPoints(ctx, na.NodeAddress, slashPoints); err != nil {
+					if err := incSlashPoints(ctx, h.keeper, na.NodeAddress, slashPoints, SlashReasonFailKeygen); err != nil {
 						ctx.Logger().Error("fail to inc slash points", "error", err)
 					}
 				} else {
@@ -157,7 +173,10 @@ func (h TssHandler) handleV1(ctx sdk.Context, msg MsgTssPool, version semver.Ver
 					}
 
 					slashBond := reserveVault.CalcNodeRewards(sdk.NewUint(uint64(slashPoints)))
-					na.Bond = common.SafeSub(na.Bond, slashBond)
+					na.SubBond(slashBond)
+					if err := addSlashRecord(ctx, h.keeper, na.NodeAddress, SlashReasonFailKeygen, 0, slashBond); err != nil {
+						ctx.Logger().Error("fail to add slash record", "error", err)
+					}
 					if common.RuneAsset().Chain.Equals(common.THORChain) {
 						coin := common.NewCoin(common.RuneNative, slashBond)
 						if err := h.keeper.SendFromModuleToModule(ctx, BondName, ReserveName, coin); err != nil {
@@ -187,3 +206,113 @@ func (h TssHandler) handleV1(ctx sdk.Context, msg MsgTssPool, version semver.Ver
 		Codespace: DefaultCodespace,
 	}
 }
+
+// rotateAsgardVaults rotate in all the asgard vaults created by the keygen block at the given height,
+// it does nothing until every asgard keygen in the keygen block have created its vault
+func (h TssHandler) rotateAsgardVaults(ctx sdk.Context, height int64, vaultMgr VaultManager) error {
+	keygenBlock, err := h.keeper.GetKeygenBlock(ctx, height)
+	if err != nil {
+		return fmt.Errorf("fail to get keygen block: %w", err)
+	}
+	asgards, err := h.keeper.GetAsgardVaultsByStatus(ctx, InitVault)
+	if err != nil {
+		return fmt.Errorf("fail to get init asgard vaults: %w", err)
+	}
+	toRotate := make(Vaults, 0, len(keygenBlock.Keygens))
+	for _, keygen := range keygenBlock.Keygens {
+		if keygen.Type != AsgardKeygen {
+			continue
+		}
+		found := false
+		for _, vault := range asgards {
+			if vault.BlockHeight >= height && vault.MembershipEquals(keygen.Members) {
+				toRotate = append(toRotate, vault)
+				found = true
+				break
+			}
+		}
+		if !found {
+			ctx.Logger().Info("waiting for other asgard vaults to be created", "keygen", keygen.ID)
+			return nil
+		}
+	}
+	for _, vault := range toRotate {
+		if err := vaultMgr.RotateVault(ctx, vault); err != nil {
+			return fmt.Errorf("fail to rotate vault(%s): %w", vault.PubKey, err)
+		}
+	}
+	// RotateVault only retires the active asgard vaults that share members
+	// with a new vault, an old vault whose members all left has to be
+	// retired as well
+	active, err := h.keeper.GetAsgardVaultsByStatus(ctx, ActiveVault)
+	if err != nil {
+		return fmt.Errorf("fail to get active asgard vaults: %w", err)
+	}
+	for _, vault := range active {
+		rotated := false
+		for _, v := range toRotate {
+			if v.PubKey.Equals(vault.PubKey) {
+				rotated = true
+				break
+			}
+		}
+		if rotated {
+			continue
+		}
+		vault.UpdateStatus(RetiringVault, ctx.BlockHeight())
+		if err := h.keeper.SetVault(ctx, vault); err != nil {
+			return fmt.Errorf("fail to save vault(%s): %w", vault.PubKey, err)
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(EventTypeInactiveVault,
+				sdk.NewAttribute("set asgard vault to inactive", vault.PubKey.String())))
+	}
+	// any init asgard vault left over is from a previous keygen set where
+	// one of the keygens failed, it will never be rotated in
+	for _, vault := range asgards {
+		rotated := false
+		for _, v := range toRotate {
+			if v.PubKey.Equals(vault.PubKey) {
+				rotated = true
+				break
+			}
+		}
+		if rotated {
+			continue
+		}
+		vault.UpdateStatus(InactiveVault, ctx.BlockHeight())
+		if err := h.keeper.SetVault(ctx, vault); err != nil {
+			return fmt.Errorf("fail to save vault(%s): %w", vault.PubKey, err)
+		}
+	}
+	return nil
+}
+
+// retireInitAsgardVaults set the init asgard vaults created by the keygen
+// block at the given height to inactive
+func (h TssHandler) retireInitAsgardVaults(ctx sdk.Context, height int64) error {
+	keygenBlock, err := h.keeper.GetKeygenBlock(ctx, height)
+	if err != nil {
+		return fmt.Errorf("fail to get keygen block: %w", err)
+	}
+	asgards, err := h.keeper.GetAsgardVaultsByStatus(ctx, InitVault)
+	if err != nil {
+		return fmt.Errorf("fail to get init asgard vaults: %w", err)
+	}
+	for _, vault := range asgards {
+		if vault.BlockHeight < height {
+			continue
+		}
+		for _, keygen := range keygenBlock.Keygens {
+			if keygen.Type != AsgardKeygen || !vault.MembershipEquals(keygen.Members) {
+				continue
+			}
+			vault.UpdateStatus(InactiveVault, ctx.BlockHeight())
+			if err := h.keeper.SetVault(ctx, vault); err != nil {
+				return fmt.Errorf("fail to save vault(%s): %w", vault.PubKey, err)
+			}
+			break
+		}
+	}
+	return nil
+}
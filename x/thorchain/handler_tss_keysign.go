@@ -1,6 +1,8 @@
 package thorchain
 
 import (
+	"fmt"
+
 	"github.com/blang/semver"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -10,14 +12,17 @@ import (
 
 // TssKeysignHandler is design to process MsgTssKeysignFail
 type TssKeysignHandler struct {
-	keeper Keeper
+	keeper                Keeper
+	versionedEventManager VersionedEventManager
 }
 
 // NewTssKeysignHandler create a new instance of TssKeysignHandler
 // when a signer fail to join tss keysign , thorchain need to slash their node account
-func NewTssKeysignHandler(keeper Keeper) TssKeysignHandler {
+// node account that fail keysign repeatedly will be put in jail
+func NewTssKeysignHandler(keeper Keeper, versionedEventManager VersionedEventManager) TssKeysignHandler {
 	return TssKeysignHandler{
-		keeper: keeper,
+		keeper:                keeper,
+		versionedEventManager: versionedEventManager,
 	}
 }
 
@@ -107,9 +112,12 @@ func (h TssKeysignHandler) handleV1(ctx sdk.Context, msg MsgTssKeysignFail, vers
 				ctx.Logger().Error("fail to get node from it's pub key", "error", err, "pub key", nodePubKey.String())
 				return sdk.ErrInternal("fail to get node account").Result()
 			}
-			if err := h.keeper.IncNodeAccountSlashPoints(ctx, na.NodeAddress, slashPoints); err != nil {
+			if err := incSlashPoints(ctx, h.keeper, na.NodeAddress, slashPoints, SlashReasonFailKeysign); err != nil {
 				ctx.Logger().Error("fail to inc slash points", "error", err)
 			}
+			if err := h.jailRepeatOffender(ctx, na, version, constAccessor); err != nil {
+				ctx.Logger().Error("fail to jail node account", "error", err)
+			}
 		}
 	}
 
@@ -118,3 +126,45 @@ func (h TssKeysignHandler) handleV1(ctx sdk.Context, msg MsgTssKeysignFail, vers
 		Codespace: DefaultCodespace,
 	}
 }
+
+// jailRepeatOffender put the node account in jail when it failed keysign too many times recently
+func (h TssKeysignHandler) jailRepeatOffender(ctx sdk.Context, na NodeAccount, version semver.Version, constAccessor constants.ConstantValues) error {
+	jailTime, err := h.keeper.GetMimir(ctx, constants.JailTimeKeysign.String())
+	if jailTime < 0 || err != nil {
+		jailTime = constAccessor.GetInt64Value(constants.JailTimeKeysign)
+	}
+	maxFailures, err := h.keeper.GetMimir(ctx, constants.KeysignFailuresToJail.String())
+	if maxFailures < 0 || err != nil {
+		maxFailures = constAccessor.GetInt64Value(constants.KeysignFailuresToJail)
+	}
+	window, err := h.keeper.GetMimir(ctx, constants.KeysignFailureWindow.String())
+	if window < 0 || err != nil {
+		window = constAccessor.GetInt64Value(constants.KeysignFailureWindow)
+	}
+	if jailTime <= 0 || maxFailures <= 0 || window <= 0 || na.IsJailed(ctx.BlockHeight()) {
+		return nil
+	}
+
+	failures, err := h.keeper.IncNodeAccountKeysignFailures(ctx, na.NodeAddress, window)
+	if err != nil {
+		return fmt.Errorf("fail to increment keysign failures: %w", err)
+	}
+	if failures < maxFailures {
+		return nil
+	}
+	h.keeper.ResetNodeAccountKeysignFailures(ctx, na.NodeAddress)
+
+	na.Jail(ctx.BlockHeight() + jailTime)
+	if err := h.keeper.SetNodeAccount(ctx, na); err != nil {
+		return fmt.Errorf("fail to save node account: %w", err)
+	}
+	eventMgr, err := h.versionedEventManager.GetEventManager(ctx, version)
+	if err != nil {
+		return fmt.Errorf("fail to get event manager: %w", err)
+	}
+	jailEvent := NewEventJail(na.NodeAddress, na.JailReleaseHeight, "failed to perform keysign")
+	if err := eventMgr.EmitJailEvent(ctx, h.keeper, jailEvent); err != nil {
+		return fmt.Errorf("fail to emit jail event: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package thorchain
 
 import (
+	"fmt"
+
 	"github.com/blang/semver"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "gopkg.in/check.v1"
@@ -262,7 +264,7 @@ func (h HandlerTssKeysignSuite) TestTssKeysignFailHandler(c *C) {
 	}
 	for _, tc := range testCases {
 		helper := newTssKeysignHandlerTestHelper(c)
-		handler := NewTssKeysignHandler(helper.keeper)
+		handler := NewTssKeysignHandler(helper.keeper, NewDummyVersionedEventMgr())
 		msg := tc.messageCreator(helper)
 		result := tc.runner(handler, msg, helper)
 		c.Assert(result.Code, Equals, tc.expectedResult, Commentf("name:%s", tc.name))
@@ -271,3 +273,31 @@ func (h HandlerTssKeysignSuite) TestTssKeysignFailHandler(c *C) {
 		}
 	}
 }
+
+func (h HandlerTssKeysignSuite) TestTssKeysignFailJail(c *C) {
+	helper := newTssKeysignHandlerTestHelper(c)
+	handler := NewTssKeysignHandler(helper.keeper, NewDummyVersionedEventMgr())
+	blamed := GetRandomNodeAccount(NodeActive)
+	c.Assert(helper.keeper.SetNodeAccount(helper.ctx, blamed), IsNil)
+	b := blame.Blame{
+		FailReason: "whatever",
+		BlameNodes: []blame.Node{{Pubkey: blamed.PubKeySet.Secp256k1.String()}},
+	}
+	maxFailures := helper.constAccessor.GetInt64Value(constants.KeysignFailuresToJail)
+	for i := int64(0); i < maxFailures; i++ {
+		na, err := helper.keeper.GetNodeAccount(helper.ctx, blamed.NodeAddress)
+		c.Assert(err, IsNil)
+		c.Check(na.IsJailed(helper.ctx.BlockHeight()), Equals, false)
+		memo := fmt.Sprintf("keysign-%d", i)
+		msg := NewMsgTssKeysignFail(helper.ctx.BlockHeight(), b, memo, common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(100))}, helper.nodeAccount.NodeAddress)
+		result := handler.Run(helper.ctx, msg, constants.SWVersion, helper.constAccessor)
+		c.Assert(result.Code, Equals, sdk.CodeOK)
+	}
+	na, err := helper.keeper.GetNodeAccount(helper.ctx, blamed.NodeAddress)
+	c.Assert(err, IsNil)
+	jailTime := helper.constAccessor.GetInt64Value(constants.JailTimeKeysign)
+	c.Check(na.JailReleaseHeight, Equals, helper.ctx.BlockHeight()+jailTime)
+	c.Check(na.IsJailed(helper.ctx.BlockHeight()), Equals, true)
+	// released automatically once the jail time is served
+	c.Check(na.IsJailed(helper.ctx.BlockHeight()+jailTime), Equals, false)
+}
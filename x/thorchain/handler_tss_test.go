@@ -119,7 +119,7 @@ func newTssHandlerTestHelper(c *C) tssHandlerTestHelper {
 
 	keygenBlock := NewKeygenBlock(ctx.BlockHeight())
 	keygenBlock.Keygens = []Keygen{
-		{Members: members},
+		{Type: AsgardKeygen, Members: members},
 	}
 	c.Assert(keeper.SetKeygenBlock(ctx, keygenBlock), IsNil)
 
@@ -586,3 +586,113 @@ func (s *HandlerTssSuite) TestTssHandler(c *C) {
 		}
 	}
 }
+
+func (s *HandlerTssSuite) TestTssHandlerMultipleAsgards(c *C) {
+	helper := newTssHandlerTestHelper(c)
+	handler := NewTssHandler(helper.keeper, helper.vaultManager)
+	var members2 common.PubKeys
+	for i := 0; i < 8; i++ {
+		members2 = append(members2, GetRandomPubKey())
+	}
+	keygenBlock, err := helper.keeper.GetKeygenBlock(helper.ctx, helper.ctx.BlockHeight())
+	c.Assert(err, IsNil)
+	keygenBlock.Keygens = append(keygenBlock.Keygens, Keygen{Type: AsgardKeygen, Members: members2})
+	c.Assert(helper.keeper.SetKeygenBlock(helper.ctx, keygenBlock), IsNil)
+
+	// the retiring asgard had members from both shards
+	oldAsgard := NewVault(helper.ctx.BlockHeight()-1, ActiveVault, AsgardVault, GetRandomPubKey(), common.Chains{common.RuneAsset().Chain})
+	oldAsgard.Membership = common.PubKeys{helper.members[0], members2[0]}
+	c.Assert(helper.keeper.SetVault(helper.ctx, oldAsgard), IsNil)
+	// none of the members of this asgard are in the new vaults
+	leftAsgard := NewVault(helper.ctx.BlockHeight()-1, ActiveVault, AsgardVault, GetRandomPubKey(), common.Chains{common.RuneAsset().Chain})
+	leftAsgard.Membership = common.PubKeys{GetRandomPubKey(), GetRandomPubKey()}
+	c.Assert(helper.keeper.SetVault(helper.ctx, leftAsgard), IsNil)
+
+	keygen := func(members common.PubKeys, poolPk common.PubKey) {
+		for _, member := range members {
+			signer, err := member.GetThorAddress()
+			c.Assert(err, IsNil)
+			msg := NewMsgTssPool(members, poolPk, AsgardKeygen, helper.ctx.BlockHeight(), blame.Blame{}, common.Chains{common.RuneAsset().Chain}, signer)
+			result := handler.Run(helper.ctx, msg, constants.SWVersion, helper.constAccessor)
+			c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%s", result.Log))
+		}
+	}
+
+	// the first asgard vault wait for the second one
+	poolPk1 := GetRandomPubKey()
+	keygen(helper.members, poolPk1)
+	vault1, err := helper.keeper.GetVault(helper.ctx, poolPk1)
+	c.Assert(err, IsNil)
+	c.Check(vault1.Status, Equals, InitVault)
+
+	poolPk2 := GetRandomPubKey()
+	keygen(members2, poolPk2)
+	for _, pk := range []common.PubKey{poolPk1, poolPk2} {
+		vault, err := helper.keeper.GetVault(helper.ctx, pk)
+		c.Assert(err, IsNil)
+		c.Check(vault.Status, Equals, ActiveVault)
+	}
+	oldAsgard, err = helper.keeper.GetVault(helper.ctx, oldAsgard.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(oldAsgard.Status, Equals, RetiringVault)
+	leftAsgard, err = helper.keeper.GetVault(helper.ctx, leftAsgard.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(leftAsgard.Status, Equals, RetiringVault)
+}
+
+func (s *HandlerTssSuite) TestTssHandlerMultipleAsgardsKeygenFail(c *C) {
+	helper := newTssHandlerTestHelper(c)
+	handler := NewTssHandler(helper.keeper, helper.vaultManager)
+	var members2 common.PubKeys
+	for i := 0; i < 8; i++ {
+		members2 = append(members2, GetRandomPubKey())
+	}
+	keygenBlock, err := helper.keeper.GetKeygenBlock(helper.ctx, helper.ctx.BlockHeight())
+	c.Assert(err, IsNil)
+	keygenBlock.Keygens = append(keygenBlock.Keygens, Keygen{Type: AsgardKeygen, Members: members2})
+	c.Assert(helper.keeper.SetKeygenBlock(helper.ctx, keygenBlock), IsNil)
+
+	keygen := func(ctx sdk.Context, height int64, members common.PubKeys, poolPk common.PubKey, b blame.Blame) {
+		for _, member := range members {
+			signer, err := member.GetThorAddress()
+			c.Assert(err, IsNil)
+			msg := NewMsgTssPool(members, poolPk, AsgardKeygen, height, b, common.Chains{common.RuneAsset().Chain}, signer)
+			result := handler.Run(ctx, msg, constants.SWVersion, helper.constAccessor)
+			c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%s", result.Log))
+		}
+	}
+	failed := blame.Blame{FailReason: "fail to keygen"}
+
+	// the vault of the first shard is retired when the second shard fails
+	height := helper.ctx.BlockHeight()
+	poolPk1 := GetRandomPubKey()
+	keygen(helper.ctx, height, helper.members, poolPk1, blame.Blame{})
+	keygen(helper.ctx, height, members2, common.EmptyPubKey, failed)
+	vault1, err := helper.keeper.GetVault(helper.ctx, poolPk1)
+	c.Assert(err, IsNil)
+	c.Check(vault1.Status, Equals, InactiveVault)
+
+	// a vault created after another shard failed is retired by the next churn
+	ctx := helper.ctx.WithBlockHeight(height + 10)
+	var members3 common.PubKeys
+	for i := 0; i < 8; i++ {
+		members3 = append(members3, GetRandomPubKey())
+	}
+	keygenBlock = NewKeygenBlock(ctx.BlockHeight())
+	keygenBlock.Keygens = []Keygen{{Type: AsgardKeygen, Members: members3}}
+	c.Assert(helper.keeper.SetKeygenBlock(ctx, keygenBlock), IsNil)
+	poolPk2 := GetRandomPubKey()
+	keygen(ctx, height, helper.members, poolPk2, blame.Blame{})
+	vault2, err := helper.keeper.GetVault(ctx, poolPk2)
+	c.Assert(err, IsNil)
+	c.Check(vault2.Status, Equals, InitVault)
+
+	poolPk3 := GetRandomPubKey()
+	keygen(ctx, ctx.BlockHeight(), members3, poolPk3, blame.Blame{})
+	vault3, err := helper.keeper.GetVault(ctx, poolPk3)
+	c.Assert(err, IsNil)
+	c.Check(vault3.Status, Equals, ActiveVault)
+	vault2, err = helper.keeper.GetVault(ctx, poolPk2)
+	c.Assert(err, IsNil)
+	c.Check(vault2.Status, Equals, InactiveVault)
+}
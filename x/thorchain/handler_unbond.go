@@ -0,0 +1,212 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+// UnbondHandler a handler to process unbond request
+// node operator ( or bond provider) can withdraw bond above the minimum bond without leaving the network
+type UnbondHandler struct {
+	keeper                Keeper
+	versionedTxOutStore   VersionedTxOutStore
+	versionedEventManager VersionedEventManager
+}
+
+// NewUnbondHandler create a new UnbondHandler
+func NewUnbondHandler(keeper Keeper, versionedTxOutStore VersionedTxOutStore, versionedEventManager VersionedEventManager) UnbondHandler {
+	return UnbondHandler{
+		keeper:                keeper,
+		versionedTxOutStore:   versionedTxOutStore,
+		versionedEventManager: versionedEventManager,
+	}
+}
+
+func (h UnbondHandler) validate(ctx sdk.Context, msg MsgUnbond, version semver.Version) sdk.Error {
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.validateV1(ctx, msg)
+	}
+	return errBadVersion
+}
+
+func (h UnbondHandler) validateV1(ctx sdk.Context, msg MsgUnbond) sdk.Error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if !isSignedByActiveNodeAccounts(ctx, h.keeper, msg.GetSigners()) {
+		return sdk.ErrUnauthorized("msg is not signed by an active node account")
+	}
+	return nil
+}
+
+// Run execute the handler
+func (h UnbondHandler) Run(ctx sdk.Context, m sdk.Msg, version semver.Version, constAccessor constants.ConstantValues) sdk.Result {
+	msg, ok := m.(MsgUnbond)
+	if !ok {
+		return errInvalidMessage.Result()
+	}
+	ctx.Logger().Info("receive MsgUnbond",
+		"node address", msg.NodeAddress,
+		"bond address", msg.BondAddress,
+		"request hash", msg.TxIn.ID,
+		"amount", msg.Amount)
+	if err := h.validate(ctx, msg, version); err != nil {
+		ctx.Logger().Error("msg unbond fail validation", "error", err)
+		return err.Result()
+	}
+
+	if err := h.handle(ctx, msg, version, constAccessor); err != nil {
+		ctx.Logger().Error("fail to process msg unbond", "error", err)
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Code:      sdk.CodeOK,
+		Codespace: DefaultCodespace,
+	}
+}
+
+func (h UnbondHandler) getNodeAccount(ctx sdk.Context, msg MsgUnbond) (NodeAccount, error) {
+	// bond providers have to specify the node they would like to unbond from
+	if !msg.NodeAddress.Empty() {
+		return h.keeper.GetNodeAccount(ctx, msg.NodeAddress)
+	}
+	return h.keeper.GetNodeAccountByBondAddress(ctx, msg.BondAddress)
+}
+
+func (h UnbondHandler) handle(ctx sdk.Context, msg MsgUnbond, version semver.Version, constAccessor constants.ConstantValues) sdk.Error {
+	nodeAcc, err := h.getNodeAccount(ctx, msg)
+	if err != nil {
+		return sdk.ErrInternal(fmt.Errorf("fail to get node account: %w", err).Error())
+	}
+	if nodeAcc.IsEmpty() {
+		return sdk.ErrUnknownRequest("node account doesn't exist")
+	}
+	if nodeAcc.Status != NodeStandby && nodeAcc.Status != NodeActive {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("node account is %s, only standby or active node can unbond", nodeAcc.Status))
+	}
+	// bond of a node account that is forced to leave is locked until it has returned its yggdrasil funds
+	if nodeAcc.ForcedToLeave {
+		return sdk.ErrUnauthorized("node account is forced to leave, bond is locked")
+	}
+	if !nodeAcc.BondAddress.Equals(msg.BondAddress) && !nodeAcc.BondProviders.Has(msg.BondAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not a bond provider of node(%s)", msg.BondAddress, nodeAcc.NodeAddress))
+	}
+	// the node operator can unbond the bond of a bond provider, which is sent back to the bond provider
+	unbondAddress := msg.BondAddress
+	if !msg.BondProviderAddress.IsEmpty() {
+		if !nodeAcc.BondAddress.Equals(msg.BondAddress) {
+			return sdk.ErrUnauthorized("only node operator can remove bond providers")
+		}
+		if !nodeAcc.BondProviders.Has(msg.BondProviderAddress) {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("%s is not a bond provider of node(%s)", msg.BondProviderAddress, nodeAcc.NodeAddress))
+		}
+		unbondAddress = msg.BondProviderAddress
+	}
+
+	// the RUNE sent along with the unbond request is added to the bond, the same as leave
+	coin := msg.TxIn.Coins.GetCoin(common.RuneAsset())
+	if !coin.IsEmpty() {
+		if nodeAcc.BondAddress.Equals(msg.BondAddress) {
+			nodeAcc.AddBond(coin.Amount)
+		} else {
+			nodeAcc.AddProviderBond(msg.BondAddress, coin.Amount)
+		}
+	}
+
+	// a bond provider without bond is removed straight away
+	if msg.Amount.IsZero() {
+		if !nodeAcc.BondOf(unbondAddress).IsZero() {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("bond provider %s still has bond, unbond it first", unbondAddress))
+		}
+		nodeAcc.RemoveBondProvider(unbondAddress)
+		if err := h.keeper.SetNodeAccount(ctx, nodeAcc); err != nil {
+			return sdk.ErrInternal(fmt.Errorf("fail to save node account: %w", err).Error())
+		}
+		return nil
+	}
+
+	minBond, err := h.keeper.GetMimir(ctx, constants.MinimumBondInRune.String())
+	if minBond < 0 || err != nil {
+		minBond = constAccessor.GetInt64Value(constants.MinimumBondInRune)
+	}
+
+	// the bond left after unbond need to cover the slash ( 1.5 x ) on the funds in the node's yggdrasil vault
+	ygg := Vault{}
+	if h.keeper.VaultExists(ctx, nodeAcc.PubKeySet.Secp256k1) {
+		ygg, err = h.keeper.GetVault(ctx, nodeAcc.PubKeySet.Secp256k1)
+		if err != nil {
+			return sdk.ErrInternal(fmt.Errorf("fail to get yggdrasil vault: %w", err).Error())
+		}
+	}
+	yggRune, err := getTotalYggValueInRune(ctx, h.keeper, ygg)
+	if err != nil {
+		return sdk.ErrInternal(fmt.Errorf("fail to get total ygg value in RUNE: %w", err).Error())
+	}
+	requiredBond := sdk.NewUint(uint64(minBond))
+	if yggCover := yggRune.MulUint64(3).QuoUint64(2); yggCover.GT(requiredBond) {
+		requiredBond = yggCover
+	}
+	if common.SafeSub(nodeAcc.Bond, msg.Amount).LT(requiredBond) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("not enough bond left after unbond, required bond(%s), bond(%s), unbond(%s)", requiredBond, nodeAcc.Bond, msg.Amount))
+	}
+	if !nodeAcc.UnbondFrom(unbondAddress, msg.Amount) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("%s doesn't have enough bond to unbond %s", unbondAddress, msg.Amount))
+	}
+	if !msg.BondProviderAddress.IsEmpty() && nodeAcc.BondOf(unbondAddress).IsZero() {
+		nodeAcc.RemoveBondProvider(unbondAddress)
+	}
+
+	active, err := h.keeper.GetAsgardVaultsByStatus(ctx, ActiveVault)
+	if err != nil {
+		return sdk.ErrInternal(fmt.Errorf("fail to get active vaults: %w", err).Error())
+	}
+	vault := active.SelectByMinCoin(common.RuneAsset())
+	if vault.IsEmpty() {
+		return sdk.ErrInternal("unable to determine asgard vault to send funds")
+	}
+
+	refundAddress := unbondAddress
+	if nodeAcc.BondAddress.Equals(unbondAddress) && common.RuneAsset().Chain.Equals(common.THORChain) {
+		refundAddress = common.Address(nodeAcc.NodeAddress.String())
+	}
+	txOutStore, err := h.versionedTxOutStore.GetTxOutStore(ctx, h.keeper, version)
+	if err != nil {
+		ctx.Logger().Error("fail to get txout store", "error", err)
+		return errBadVersion
+	}
+	txOutItem := &TxOutItem{
+		Chain:       common.RuneAsset().Chain,
+		ToAddress:   refundAddress,
+		VaultPubKey: vault.PubKey,
+		InHash:      msg.TxIn.ID,
+		Coin:        common.NewCoin(common.RuneAsset(), msg.Amount),
+	}
+	ok, err := txOutStore.TryAddTxOutItem(ctx, txOutItem)
+	if err != nil {
+		return sdk.ErrInternal(fmt.Errorf("fail to add outbound tx: %w", err).Error())
+	}
+	if !ok {
+		return sdk.NewError(DefaultCodespace, CodeFailAddOutboundTx, "prepare outbound tx not successful")
+	}
+
+	if err := h.keeper.SetNodeAccount(ctx, nodeAcc); err != nil {
+		return sdk.ErrInternal(fmt.Errorf("fail to save node account: %w", err).Error())
+	}
+
+	eventMgr, err := h.versionedEventManager.GetEventManager(ctx, version)
+	if err != nil {
+		ctx.Logger().Error("fail to get event manager", "error", err)
+		return errFailGetEventManager
+	}
+	bondEvent := NewEventBond(msg.Amount, BondReturned, msg.TxIn)
+	if err := eventMgr.EmitBondEvent(ctx, h.keeper, bondEvent); err != nil {
+		return sdk.NewError(DefaultCodespace, CodeFailSaveEvent, "fail to emit bond event")
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type HandlerUnbondSuite struct{}
+
+var _ = Suite(&HandlerUnbondSuite{})
+
+func (HandlerUnbondSuite) TestUnbondHandler(c *C) {
+	w := getHandlerTestWrapper(c, 1, true, false)
+	vault := GetRandomVault()
+	c.Assert(w.keeper.SetVault(w.ctx, vault), IsNil)
+	w.keeper.SetMimir(w.ctx, constants.MinimumBondInRune.String(), 100*common.One)
+	FundModule(c, w.ctx, w.keeper, AsgardName, 1000)
+
+	unbondHandler := NewUnbondHandler(w.keeper, w.versionedTxOutStore, NewVersionedEventMgr())
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+
+	na := GetRandomNodeAccount(NodeStandby)
+	na.Bond = sdk.NewUint(200 * common.One)
+	na.OperatorFee = 0
+	provider := GetRandomBNBAddress()
+	na.AddBondProvider(provider)
+	c.Assert(na.AddProviderBond(provider, sdk.NewUint(50*common.One)), Equals, true)
+	c.Assert(w.keeper.SetNodeAccount(w.ctx, na), IsNil)
+
+	newMsg := func(from common.Address, amt uint64, nodeAddr sdk.AccAddress) MsgUnbond {
+		tx := GetRandomTx()
+		tx.FromAddress = from
+		tx.Coins = common.Coins{common.NewCoin(common.RuneAsset(), sdk.OneUint())}
+		return NewMsgUnbond(tx, nodeAddr, sdk.NewUint(amt*common.One), from, common.NoAddress, w.activeNodeAccount.NodeAddress)
+	}
+
+	// bad version
+	result := unbondHandler.Run(w.ctx, newMsg(na.BondAddress, 10, nil), semver.Version{}, constAccessor)
+	c.Assert(result.Code, Equals, CodeBadVersion)
+
+	// not signed by an active node account
+	msg := newMsg(na.BondAddress, 10, nil)
+	msg.Signer = GetRandomBech32Addr()
+	result = unbondHandler.Run(w.ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnauthorized)
+
+	// random address is not allowed to unbond
+	result = unbondHandler.Run(w.ctx, newMsg(GetRandomBNBAddress(), 10, na.NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnauthorized)
+
+	// can't unbond below the minimum bond
+	result = unbondHandler.Run(w.ctx, newMsg(na.BondAddress, 160, nil), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnknownRequest)
+
+	// operator unbond part of their bond
+	result = unbondHandler.Run(w.ctx, newMsg(na.BondAddress, 50, nil), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%+v", result))
+	na, err := w.keeper.GetNodeAccount(w.ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Uint64(), Equals, uint64(200*common.One+1))
+	c.Check(na.OperatorBond().Uint64(), Equals, uint64(150*common.One+1))
+
+	// bond provider can't unbond more than they own
+	result = unbondHandler.Run(w.ctx, newMsg(provider, 51, na.NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnknownRequest)
+
+	// bond provider unbond
+	result = unbondHandler.Run(w.ctx, newMsg(provider, 20, na.NodeAddress), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%+v", result))
+	na, err = w.keeper.GetNodeAccount(w.ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.BondProviders.Get(provider).Bond.Uint64(), Equals, uint64(30*common.One+1))
+	c.Check(na.Bond.Uint64(), Equals, uint64(180*common.One+2))
+
+	items, err := w.versionedTxOutStore.GetTxOutStore(w.ctx, w.keeper, ver)
+	c.Assert(err, IsNil)
+	outbounds, err := items.GetOutboundItems(w.ctx)
+	c.Assert(err, IsNil)
+	c.Assert(outbounds, HasLen, 2)
+	c.Check(outbounds[1].ToAddress.Equals(provider), Equals, true)
+
+	// only node operator can remove a bond provider
+	msg = newMsg(provider, 30, na.NodeAddress)
+	msg.BondProviderAddress = provider
+	result = unbondHandler.Run(w.ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnauthorized)
+
+	// bond provider that still has bond can't be removed without unbonding it
+	msg = newMsg(na.BondAddress, 0, nil)
+	msg.BondProviderAddress = provider
+	result = unbondHandler.Run(w.ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnknownRequest)
+
+	// node operator unbond all the bond of the bond provider, which remove the bond provider
+	msg = newMsg(na.BondAddress, 0, nil)
+	msg.Amount = na.BondProviders.Get(provider).Bond
+	msg.BondProviderAddress = provider
+	result = unbondHandler.Run(w.ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%+v", result))
+	na, err = w.keeper.GetNodeAccount(w.ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.BondProviders, HasLen, 0)
+	outbounds, err = items.GetOutboundItems(w.ctx)
+	c.Assert(err, IsNil)
+	c.Assert(outbounds, HasLen, 3)
+	c.Check(outbounds[2].ToAddress.Equals(provider), Equals, true)
+
+	// a bond provider without bond is removed with a zero amount
+	na.AddBondProvider(provider)
+	c.Assert(w.keeper.SetNodeAccount(w.ctx, na), IsNil)
+	msg = newMsg(na.BondAddress, 0, nil)
+	msg.BondProviderAddress = provider
+	result = unbondHandler.Run(w.ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%+v", result))
+	na, err = w.keeper.GetNodeAccount(w.ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.BondProviders, HasLen, 0)
+
+	// bond is locked when node is forced to leave
+	na.ForcedToLeave = true
+	c.Assert(w.keeper.SetNodeAccount(w.ctx, na), IsNil)
+	result = unbondHandler.Run(w.ctx, newMsg(na.BondAddress, 1, nil), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnauthorized)
+	na.ForcedToLeave = false
+
+	// node that is not standby or active can't unbond
+	na.Status = NodeDisabled
+	c.Assert(w.keeper.SetNodeAccount(w.ctx, na), IsNil)
+	result = unbondHandler.Run(w.ctx, newMsg(na.BondAddress, 1, nil), ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnknownRequest)
+}
+
+func (HandlerUnbondSuite) TestUnbondWithYggdrasil(c *C) {
+	w := getHandlerTestWrapper(c, 1, true, false)
+	vault := GetRandomVault()
+	c.Assert(w.keeper.SetVault(w.ctx, vault), IsNil)
+	w.keeper.SetMimir(w.ctx, constants.MinimumBondInRune.String(), common.One)
+	FundModule(c, w.ctx, w.keeper, AsgardName, 1000)
+
+	na := GetRandomNodeAccount(NodeActive)
+	na.Bond = sdk.NewUint(200 * common.One)
+	c.Assert(w.keeper.SetNodeAccount(w.ctx, na), IsNil)
+	ygg := NewVault(w.ctx.BlockHeight(), ActiveVault, YggdrasilVault, na.PubKeySet.Secp256k1, common.Chains{common.RuneAsset().Chain})
+	ygg.AddFunds(common.Coins{common.NewCoin(common.RuneAsset(), sdk.NewUint(100*common.One))})
+	c.Assert(w.keeper.SetVault(w.ctx, ygg), IsNil)
+
+	unbondHandler := NewUnbondHandler(w.keeper, w.versionedTxOutStore, NewVersionedEventMgr())
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	tx := GetRandomTx()
+	tx.FromAddress = na.BondAddress
+	tx.Coins = common.Coins{common.NewCoin(common.RuneAsset(), sdk.OneUint())}
+
+	// bond left need to cover 1.5x of the yggdrasil funds
+	msg := NewMsgUnbond(tx, nil, sdk.NewUint(60*common.One), na.BondAddress, common.NoAddress, w.activeNodeAccount.NodeAddress)
+	result := unbondHandler.Run(w.ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeUnknownRequest)
+
+	msg = NewMsgUnbond(tx, nil, sdk.NewUint(50*common.One), na.BondAddress, common.NoAddress, w.activeNodeAccount.NodeAddress)
+	result = unbondHandler.Run(w.ctx, msg, ver, constAccessor)
+	c.Assert(result.Code, Equals, sdk.CodeOK, Commentf("%+v", result))
+}
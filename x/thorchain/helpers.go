@@ -191,7 +191,12 @@ func refundBond(ctx sdk.Context, tx common.Tx, nodeAcc NodeAccount, keeper Keepe
 	// slashing 1.5 * yggdrasil remains
 	slashRune := yggRune.MulUint64(3).QuoUint64(2)
 	bondBeforeSlash := nodeAcc.Bond
-	nodeAcc.Bond = common.SafeSub(nodeAcc.Bond, slashRune)
+	nodeAcc.SubBond(slashRune)
+	if slashed := common.SafeSub(bondBeforeSlash, nodeAcc.Bond); !slashed.IsZero() {
+		if err := addSlashRecord(ctx, keeper, nodeAcc.NodeAddress, SlashReasonYggdrasilLeftover, 0, slashed); err != nil {
+			ctx.Logger().Error("fail to add slash record", "error", err)
+		}
+	}
 
 	if !nodeAcc.Bond.IsZero() {
 		active, err := keeper.GetAsgardVaultsByStatus(ctx, ActiveVault)
@@ -215,17 +220,19 @@ func refundBond(ctx sdk.Context, tx common.Tx, nodeAcc NodeAccount, keeper Keepe
 			refundAddress = common.Address(nodeAcc.NodeAddress.String())
 		}
 
-		// refund bond
-		txOutItem := &TxOutItem{
-			Chain:       common.RuneAsset().Chain,
-			ToAddress:   refundAddress,
-			VaultPubKey: vault.PubKey,
-			InHash:      tx.ID,
-			Coin:        common.NewCoin(common.RuneAsset(), nodeAcc.Bond),
-		}
-		_, err = txOut.TryAddTxOutItem(ctx, txOutItem)
-		if err != nil {
-			return fmt.Errorf("fail to add outbound tx: %w", err)
+		// refund bond, each bond provider get their share back
+		for _, refund := range getBondRefunds(nodeAcc, refundAddress, nodeAcc.Bond) {
+			txOutItem := &TxOutItem{
+				Chain:       common.RuneAsset().Chain,
+				ToAddress:   refund.Address,
+				VaultPubKey: vault.PubKey,
+				InHash:      tx.ID,
+				Coin:        common.NewCoin(common.RuneAsset(), refund.Amount),
+			}
+			_, err = txOut.TryAddTxOutItem(ctx, txOutItem)
+			if err != nil {
+				return fmt.Errorf("fail to add outbound tx: %w", err)
+			}
 		}
 	} else {
 		// if it get into here that means the node account doesn't have any bond left after slash.
@@ -233,7 +240,7 @@ func refundBond(ctx sdk.Context, tx common.Tx, nodeAcc NodeAccount, keeper Keepe
 		slashRune = bondBeforeSlash
 	}
 
-	nodeAcc.Bond = sdk.ZeroUint()
+	nodeAcc.SubBond(nodeAcc.Bond)
 	// disable the node account
 	nodeAcc.UpdateStatus(NodeDisabled, ctx.BlockHeight())
 	if err := keeper.SetNodeAccount(ctx, nodeAcc); err != nil {
@@ -251,6 +258,33 @@ func refundBond(ctx sdk.Context, tx common.Tx, nodeAcc NodeAccount, keeper Keepe
 	return nil
 }
 
+type bondRefund struct {
+	Address common.Address
+	Amount  sdk.Uint
+}
+
+// getBondRefunds split the given amount of a node's bond between the node
+// operator and its bond providers, pro-rata to their share of the bond
+func getBondRefunds(nodeAcc NodeAccount, operatorAddr common.Address, amt sdk.Uint) []bondRefund {
+	if nodeAcc.Bond.IsZero() || amt.IsZero() {
+		return nil
+	}
+	operatorAmt := amt
+	var providerRefunds []bondRefund
+	for _, bp := range nodeAcc.BondProviders {
+		share := bp.Bond.Mul(amt).Quo(nodeAcc.Bond)
+		if share.IsZero() {
+			continue
+		}
+		providerRefunds = append(providerRefunds, bondRefund{Address: bp.BondAddress, Amount: share})
+		operatorAmt = common.SafeSub(operatorAmt, share)
+	}
+	if operatorAmt.IsZero() {
+		return providerRefunds
+	}
+	return append([]bondRefund{{Address: operatorAddr, Amount: operatorAmt}}, providerRefunds...)
+}
+
 // Checks if the observed vault pubkey is a valid asgard or ygg vault
 func isCurrentVaultPubKey(ctx sdk.Context, keeper Keeper, tx ObservedTx) bool {
 	return keeper.VaultExists(ctx, tx.ObservedPubKey)
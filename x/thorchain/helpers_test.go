@@ -15,10 +15,16 @@ var _ = Suite(&HelperSuite{})
 
 type TestRefundBondKeeper struct {
 	KVStoreDummy
-	ygg    Vault
-	pool   Pool
-	na     NodeAccount
-	vaults Vaults
+	ygg     Vault
+	pool    Pool
+	na      NodeAccount
+	vaults  Vaults
+	records SlashRecords
+}
+
+func (k *TestRefundBondKeeper) AddNodeAccountSlashRecord(_ sdk.Context, _ sdk.AccAddress, record SlashRecord) error {
+	k.records = append(k.records, record)
+	return nil
 }
 
 func (k *TestRefundBondKeeper) GetAsgardVaultsByStatus(_ sdk.Context, _ VaultStatus) (Vaults, error) {
@@ -241,6 +247,9 @@ func (s *HelperSuite) TestRefundBondHappyPath(c *C) {
 	c.Assert(p.BalanceRune.Equal(expectedPoolRune), Equals, true, Commentf("expect %s however we got %s", expectedPoolRune, p.BalanceRune))
 	expectedPoolBNB := sdk.NewUint(167 * common.One).Sub(sdk.NewUint(27 * common.One))
 	c.Assert(p.BalanceAsset.Equal(expectedPoolBNB), Equals, true, Commentf("expected BNB in pool %s , however we got %s", expectedPoolBNB, p.BalanceAsset))
+	c.Assert(keeper.records, HasLen, 1)
+	c.Check(keeper.records[0].Reason, Equals, SlashReasonYggdrasilLeftover)
+	c.Check(keeper.records[0].Bond.Equal(slashAmt), Equals, true)
 }
 
 func (s *HelperSuite) TestEnableNextPool(c *C) {
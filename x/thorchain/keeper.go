@@ -46,6 +46,11 @@ type Keeper interface {
 	KeeperBanVoter
 	KeeperSwapQueue
 	KeeperMimir
+	KeeperSlashRecords
+	KeeperMigrationPlan
+	KeeperMemoRef
+	KeeperTHORName
+	KeeperPendingLiquidity
 }
 
 // NOTE: Always end a dbPrefix with a slash ("/"). This is to ensure that there
@@ -86,6 +91,18 @@ const (
 	prefixNodeSlashPoints    dbPrefix = "slash/"
 	prefixSwapQueueItem      dbPrefix = "swapitem/"
 	prefixMimir              dbPrefix = "mimir/"
+	prefixNodeSlashRecords   dbPrefix = "slash_records/"
+	prefixSlashRecordIndex   dbPrefix = "slash_record_index/"
+	prefixKeysignFailures    dbPrefix = "keysign_failures/"
+	prefixObserverStats      dbPrefix = "observer_stats/"
+	prefixMigrationPlan      dbPrefix = "migration_plan/"
+	prefixNetworkFee         dbPrefix = "network_fee/"
+	prefixNetworkFeeReport   dbPrefix = "network_fee_report/"
+	prefixMemoRef            dbPrefix = "memo_ref/"
+	prefixLastMemoRefID      dbPrefix = "last_memo_ref_id/"
+	prefixTHORName           dbPrefix = "thorname/"
+	prefixPendingLiquidity   dbPrefix = "pending_liquidity/"
+	prefixPendingExpiry      dbPrefix = "pending_liquidity_expiry/"
 )
 
 func dbError(ctx sdk.Context, wrapper string, err error) error {
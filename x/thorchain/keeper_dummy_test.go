@@ -133,6 +133,11 @@ func (k KVStoreDummy) IncNodeAccountSlashPoints(_ sdk.Context, _ sdk.AccAddress,
 func (k KVStoreDummy) DecNodeAccountSlashPoints(_ sdk.Context, _ sdk.AccAddress, _ int64) error {
 	return kaboom
 }
+func (k KVStoreDummy) IncNodeAccountKeysignFailures(_ sdk.Context, _ sdk.AccAddress, _ int64) (int64, error) {
+	return 0, kaboom
+}
+func (k KVStoreDummy) ResetNodeAccountKeysignFailures(_ sdk.Context, _ sdk.AccAddress) {}
+
 func (k KVStoreDummy) SetActiveObserver(_ sdk.Context, _ sdk.AccAddress)     {}
 func (k KVStoreDummy) RemoveActiveObserver(_ sdk.Context, _ sdk.AccAddress)  {}
 func (k KVStoreDummy) IsActiveObserver(_ sdk.Context, _ sdk.AccAddress) bool { return false }
@@ -141,8 +146,12 @@ func (k KVStoreDummy) GetObservingAddresses(_ sdk.Context) ([]sdk.AccAddress, er
 }
 func (k KVStoreDummy) AddObservingAddresses(_ sdk.Context, _ []sdk.AccAddress) error { return kaboom }
 func (k KVStoreDummy) ClearObservingAddresses(_ sdk.Context)                         {}
-func (k KVStoreDummy) SetObservedTxVoter(_ sdk.Context, _ ObservedTxVoter)           {}
-func (k KVStoreDummy) GetObservedTxVoterIterator(_ sdk.Context) sdk.Iterator         { return nil }
+func (k KVStoreDummy) GetObserverStats(_ sdk.Context, _ sdk.AccAddress) (ObserverStats, error) {
+	return ObserverStats{}, kaboom
+}
+func (k KVStoreDummy) SetObserverStats(_ sdk.Context, _ ObserverStats)       {}
+func (k KVStoreDummy) SetObservedTxVoter(_ sdk.Context, _ ObservedTxVoter)   {}
+func (k KVStoreDummy) GetObservedTxVoterIterator(_ sdk.Context) sdk.Iterator { return nil }
 func (k KVStoreDummy) GetObservedTxVoter(_ sdk.Context, _ common.TxID) (ObservedTxVoter, error) {
 	return ObservedTxVoter{}, kaboom
 }
@@ -236,6 +245,17 @@ func (k KVStoreDummy) GetGas(_ sdk.Context, _ common.Asset) ([]sdk.Uint, error)
 }
 func (k KVStoreDummy) SetGas(_ sdk.Context, _ common.Asset, _ []sdk.Uint) {}
 func (k KVStoreDummy) GetGasIterator(ctx sdk.Context) sdk.Iterator        { return nil }
+func (k KVStoreDummy) GetNetworkFee(_ sdk.Context, _ common.Chain) (NetworkFee, error) {
+	return NetworkFee{}, kaboom
+}
+func (k KVStoreDummy) SaveNetworkFee(_ sdk.Context, _ common.Chain, _ NetworkFee) error {
+	return kaboom
+}
+func (k KVStoreDummy) GetNetworkFeeReports(_ sdk.Context, _ common.Chain) (NetworkFeeReports, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) SetNetworkFeeReport(_ sdk.Context, _ NetworkFeeReport)    {}
+func (k KVStoreDummy) RemoveNetworkFeeReport(_ sdk.Context, _ NetworkFeeReport) {}
 
 func (k KVStoreDummy) ListTxMarker(_ sdk.Context, _ string) (TxMarkers, error) {
 	return nil, kaboom
@@ -261,6 +281,35 @@ func (k KVStoreDummy) GetSwapQueueItem(ctx sdk.Context, txID common.TxID) (MsgSw
 func (k KVStoreDummy) GetMimir(_ sdk.Context, key string) (int64, error) { return 0, kaboom }
 func (k KVStoreDummy) SetMimir(_ sdk.Context, key string, value int64)   {}
 func (k KVStoreDummy) GetMimirIterator(ctx sdk.Context) sdk.Iterator     { return nil }
+func (k KVStoreDummy) AddNodeAccountSlashRecord(_ sdk.Context, _ sdk.AccAddress, _ SlashRecord) error {
+	return kaboom
+}
+func (k KVStoreDummy) GetNodeAccountSlashRecords(_ sdk.Context, _ sdk.AccAddress) (SlashRecords, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) GetMigrationPlan(_ sdk.Context, _ common.PubKey) (MigrationPlan, error) {
+	return MigrationPlan{}, kaboom
+}
+func (k KVStoreDummy) SetMigrationPlan(_ sdk.Context, _ MigrationPlan) error { return kaboom }
+func (k KVStoreDummy) RemoveMigrationPlan(_ sdk.Context, _ common.PubKey)    {}
+func (k KVStoreDummy) GetMemoRef(_ sdk.Context, _ int64) (MemoRef, error) {
+	return MemoRef{}, kaboom
+}
+func (k KVStoreDummy) SetMemoRef(_ sdk.Context, _ MemoRef) error     { return kaboom }
+func (k KVStoreDummy) GetNextMemoRefID(_ sdk.Context) (int64, error) { return 0, kaboom }
+func (k KVStoreDummy) GetTHORName(_ sdk.Context, _ string) (THORName, error) {
+	return THORName{}, kaboom
+}
+func (k KVStoreDummy) SetTHORName(_ sdk.Context, _ THORName) error            { return kaboom }
+func (k KVStoreDummy) GetPendingLiquidityIterator(_ sdk.Context) sdk.Iterator { return nil }
+func (k KVStoreDummy) GetPendingLiquidity(_ sdk.Context, _ common.Asset, _, _ common.Address) (PendingLiquidity, error) {
+	return PendingLiquidity{}, kaboom
+}
+func (k KVStoreDummy) GetExpiredPendingLiquidity(_ sdk.Context, _ int64) ([]PendingLiquidity, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) SetPendingLiquidity(_ sdk.Context, _ PendingLiquidity) error { return kaboom }
+func (k KVStoreDummy) RemovePendingLiquidity(_ sdk.Context, _ PendingLiquidity)    {}
 
 // a mock sdk.Iterator implementation for testing purposes
 type DummyIterator struct {
@@ -1,6 +1,8 @@
 package thorchain
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"gitlab.com/thorchain/thornode/common"
@@ -10,6 +12,11 @@ type KeeperGas interface {
 	GetGas(_ sdk.Context, asset common.Asset) ([]sdk.Uint, error)
 	SetGas(_ sdk.Context, asset common.Asset, units []sdk.Uint)
 	GetGasIterator(ctx sdk.Context) sdk.Iterator
+	GetNetworkFee(ctx sdk.Context, chain common.Chain) (NetworkFee, error)
+	SaveNetworkFee(ctx sdk.Context, chain common.Chain, networkFee NetworkFee) error
+	GetNetworkFeeReports(ctx sdk.Context, chain common.Chain) (NetworkFeeReports, error)
+	SetNetworkFeeReport(ctx sdk.Context, report NetworkFeeReport)
+	RemoveNetworkFeeReport(ctx sdk.Context, report NetworkFeeReport)
 }
 
 func (k KVStore) GetGas(ctx sdk.Context, asset common.Asset) ([]sdk.Uint, error) {
@@ -38,3 +45,59 @@ func (k KVStore) GetGasIterator(ctx sdk.Context) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
 	return sdk.KVStorePrefixIterator(store, []byte(prefixGas))
 }
+
+// GetNetworkFee get the network fee of the given chain, an empty NetworkFee will be returned when there is none
+func (k KVStore) GetNetworkFee(ctx sdk.Context, chain common.Chain) (NetworkFee, error) {
+	networkFee := NetworkFee{Chain: chain}
+	key := k.GetKey(ctx, prefixNetworkFee, chain.String())
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return networkFee, nil
+	}
+	if err := k.cdc.UnmarshalBinaryBare(store.Get([]byte(key)), &networkFee); err != nil {
+		return networkFee, dbError(ctx, "Unmarshal: network fee", err)
+	}
+	return networkFee, nil
+}
+
+// SaveNetworkFee save the network fee of the given chain
+func (k KVStore) SaveNetworkFee(ctx sdk.Context, chain common.Chain, networkFee NetworkFee) error {
+	if err := networkFee.Valid(); err != nil {
+		return fmt.Errorf("network fee is invalid: %w", err)
+	}
+	key := k.GetKey(ctx, prefixNetworkFee, chain.String())
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(networkFee))
+	return nil
+}
+
+// GetNetworkFeeReports get the latest network fee of the given chain reported by each node account
+func (k KVStore) GetNetworkFeeReports(ctx sdk.Context, chain common.Chain) (NetworkFeeReports, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixNetworkFeeReport, chain.String()+"/")
+	iter := sdk.KVStorePrefixIterator(store, []byte(key))
+	defer iter.Close()
+	var reports NetworkFeeReports
+	for ; iter.Valid(); iter.Next() {
+		var report NetworkFeeReport
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &report); err != nil {
+			return nil, dbError(ctx, "Unmarshal: network fee report", err)
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// SetNetworkFeeReport save the network fee reported by a node account, it replaces the previous report of the node on the same chain
+func (k KVStore) SetNetworkFeeReport(ctx sdk.Context, report NetworkFeeReport) {
+	key := k.GetKey(ctx, prefixNetworkFeeReport, report.Chain.String()+"/"+report.NodeAddress.String())
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(report))
+}
+
+// RemoveNetworkFeeReport delete the network fee reported by a node account on the chain of the given report
+func (k KVStore) RemoveNetworkFeeReport(ctx sdk.Context, report NetworkFeeReport) {
+	key := k.GetKey(ctx, prefixNetworkFeeReport, report.Chain.String()+"/"+report.NodeAddress.String())
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(key))
+}
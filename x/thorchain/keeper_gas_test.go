@@ -26,3 +26,28 @@ func (s *KeeperGasSuite) TestGas(c *C) {
 	c.Assert(gas[0].Equal(sdk.NewUint(37500)), Equals, true)
 	c.Assert(gas[1].Equal(sdk.NewUint(30000)), Equals, true)
 }
+
+func (s *KeeperGasSuite) TestNetworkFee(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	fee, err := k.GetNetworkFee(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(fee.IsEmpty(), Equals, true)
+
+	c.Assert(k.SaveNetworkFee(ctx, common.BTCChain, NetworkFee{}), NotNil)
+	c.Assert(k.SaveNetworkFee(ctx, common.BTCChain, NewNetworkFee(common.BTCChain, 250, 10)), IsNil)
+	fee, err = k.GetNetworkFee(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(fee.TransactionSize, Equals, uint64(250))
+	c.Assert(fee.TransactionFeeRate, Equals, uint64(10))
+
+	addr := GetRandomBech32Addr()
+	k.SetNetworkFeeReport(ctx, NetworkFeeReport{NodeAddress: addr, BlockHeight: 1, Chain: common.BTCChain, TransactionSize: 250, TransactionFeeRate: 10})
+	k.SetNetworkFeeReport(ctx, NetworkFeeReport{NodeAddress: addr, BlockHeight: 2, Chain: common.BTCChain, TransactionSize: 250, TransactionFeeRate: 20})
+	k.SetNetworkFeeReport(ctx, NetworkFeeReport{NodeAddress: GetRandomBech32Addr(), BlockHeight: 2, Chain: common.BNBChain, TransactionSize: 1, TransactionFeeRate: 37500})
+	reports, err := k.GetNetworkFeeReports(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(reports, HasLen, 1)
+	c.Assert(reports[0].BlockHeight, Equals, int64(2))
+	c.Assert(reports[0].TransactionFeeRate, Equals, uint64(20))
+}
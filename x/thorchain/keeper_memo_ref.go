@@ -0,0 +1,54 @@
+package thorchain
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type KeeperMemoRef interface {
+	GetMemoRef(ctx sdk.Context, id int64) (MemoRef, error)
+	SetMemoRef(ctx sdk.Context, ref MemoRef) error
+	GetNextMemoRefID(ctx sdk.Context) (int64, error)
+}
+
+// GetMemoRef - get the registered memo with the given id, an empty MemoRef
+// will be returned when there is none
+func (k KVStore) GetMemoRef(ctx sdk.Context, id int64) (MemoRef, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixMemoRef, strconv.FormatInt(id, 10))
+	if !store.Has([]byte(key)) {
+		return MemoRef{}, nil
+	}
+	var ref MemoRef
+	if err := k.cdc.UnmarshalBinaryBare(store.Get([]byte(key)), &ref); err != nil {
+		return MemoRef{}, dbError(ctx, "Unmarshal: memo ref", err)
+	}
+	return ref, nil
+}
+
+// SetMemoRef - save a registered memo
+func (k KVStore) SetMemoRef(ctx sdk.Context, ref MemoRef) error {
+	if err := ref.Valid(); err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixMemoRef, strconv.FormatInt(ref.ID, 10))
+	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(ref))
+	return nil
+}
+
+// GetNextMemoRefID - get the id of the next registered memo, the id starts from 1
+func (k KVStore) GetNextMemoRefID(ctx sdk.Context) (int64, error) {
+	var lastID int64
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixLastMemoRefID, "")
+	if store.Has([]byte(key)) {
+		if err := k.cdc.UnmarshalBinaryBare(store.Get([]byte(key)), &lastID); err != nil {
+			return 0, dbError(ctx, "Unmarshal: last memo ref id", err)
+		}
+	}
+	nextID := lastID + 1
+	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(nextID))
+	return nextID, nil
+}
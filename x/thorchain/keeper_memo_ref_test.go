@@ -0,0 +1,33 @@
+package thorchain
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type KeeperMemoRefSuite struct{}
+
+var _ = Suite(&KeeperMemoRefSuite{})
+
+func (s *KeeperMemoRefSuite) TestMemoRef(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	ref, err := k.GetMemoRef(ctx, 1)
+	c.Assert(err, IsNil)
+	c.Assert(ref.IsEmpty(), Equals, true)
+
+	id, err := k.GetNextMemoRefID(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(id, Equals, int64(1))
+	id, err = k.GetNextMemoRefID(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(id, Equals, int64(2))
+
+	c.Assert(k.SetMemoRef(ctx, MemoRef{}), NotNil)
+	owner := GetRandomBech32Addr()
+	c.Assert(k.SetMemoRef(ctx, NewMemoRef(id, "swap:BNB.BNB", owner, 10)), IsNil)
+	ref, err = k.GetMemoRef(ctx, id)
+	c.Assert(err, IsNil)
+	c.Check(ref.Memo, Equals, "swap:BNB.BNB")
+	c.Check(ref.Owner.Equals(owner), Equals, true)
+	c.Check(ref.BlockHeight, Equals, int64(10))
+}
@@ -0,0 +1,47 @@
+package thorchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type KeeperMigrationPlan interface {
+	GetMigrationPlan(ctx sdk.Context, pk common.PubKey) (MigrationPlan, error)
+	SetMigrationPlan(ctx sdk.Context, plan MigrationPlan) error
+	RemoveMigrationPlan(ctx sdk.Context, pk common.PubKey)
+}
+
+// GetMigrationPlan - get the migration plan of the given retiring vault, an
+// empty plan will be returned when the vault doesn't have one yet
+func (k KVStore) GetMigrationPlan(ctx sdk.Context, pk common.PubKey) (MigrationPlan, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixMigrationPlan, pk.String())
+	if !store.Has([]byte(key)) {
+		return MigrationPlan{}, nil
+	}
+	var plan MigrationPlan
+	if err := k.cdc.UnmarshalBinaryBare(store.Get([]byte(key)), &plan); err != nil {
+		return MigrationPlan{}, dbError(ctx, "Unmarshal: migration plan", err)
+	}
+	return plan, nil
+}
+
+// SetMigrationPlan - save the migration plan of a retiring vault
+func (k KVStore) SetMigrationPlan(ctx sdk.Context, plan MigrationPlan) error {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixMigrationPlan, plan.VaultPubKey.String())
+	buf, err := k.cdc.MarshalBinaryBare(plan)
+	if err != nil {
+		return dbError(ctx, "fail to marshal migration plan", err)
+	}
+	store.Set([]byte(key), buf)
+	return nil
+}
+
+// RemoveMigrationPlan - delete the migration plan of a vault that is done retiring
+func (k KVStore) RemoveMigrationPlan(ctx sdk.Context, pk common.PubKey) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixMigrationPlan, pk.String())
+	store.Delete([]byte(key))
+}
@@ -0,0 +1,39 @@
+package thorchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type KeeperMigrationPlanSuite struct{}
+
+var _ = Suite(&KeeperMigrationPlanSuite{})
+
+func (s *KeeperMigrationPlanSuite) TestMigrationPlan(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(100*common.One)),
+	}
+
+	plan, err := k.GetMigrationPlan(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(plan.IsEmpty(), Equals, true)
+
+	plan = NewMigrationPlan(10, vault)
+	plan.AddTxIssued(vault.Coins[0])
+	c.Assert(k.SetMigrationPlan(ctx, plan), IsNil)
+	plan, err = k.GetMigrationPlan(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(plan.VaultPubKey.Equals(vault.PubKey), Equals, true)
+	c.Check(plan.StartHeight, Equals, int64(10))
+	c.Assert(plan.Items, HasLen, 1)
+	c.Check(plan.Items[0].TxIssued, Equals, int64(1))
+
+	k.RemoveMigrationPlan(ctx, vault.PubKey)
+	plan, err = k.GetMigrationPlan(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(plan.IsEmpty(), Equals, true)
+}
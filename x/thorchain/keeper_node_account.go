@@ -30,6 +30,15 @@ type KeeperNodeAccount interface {
 	IncNodeAccountSlashPoints(_ sdk.Context, _ sdk.AccAddress, _ int64) error
 	DecNodeAccountSlashPoints(_ sdk.Context, _ sdk.AccAddress, _ int64) error
 	ResetNodeAccountSlashPoints(_ sdk.Context, _ sdk.AccAddress)
+	IncNodeAccountKeysignFailures(_ sdk.Context, _ sdk.AccAddress, window int64) (int64, error)
+	ResetNodeAccountKeysignFailures(_ sdk.Context, _ sdk.AccAddress)
+}
+
+// keysignFailures is the number of keysign failures of a node account since
+// Height
+type keysignFailures struct {
+	Height int64 `json:"height"`
+	Count  int64 `json:"count"`
 }
 
 // TotalActiveNodeAccount count the number of active node account
@@ -311,3 +320,31 @@ func (k KVStore) DecNodeAccountSlashPoints(ctx sdk.Context, addr sdk.AccAddress,
 	k.SetNodeAccountSlashPoints(ctx, addr, current-pts)
 	return nil
 }
+
+// IncNodeAccountKeysignFailures - increments the number of keysign failures
+// of the given node address, and returns it. The count restarts once window
+// blocks have passed since the first failure counted
+func (k KVStore) IncNodeAccountKeysignFailures(ctx sdk.Context, addr sdk.AccAddress, window int64) (int64, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixKeysignFailures, addr.String())
+	var failures keysignFailures
+	if store.Has([]byte(key)) {
+		if err := k.cdc.UnmarshalBinaryBare(store.Get([]byte(key)), &failures); err != nil {
+			return 0, dbError(ctx, "Unmarshal: node account keysign failures", err)
+		}
+	}
+	if failures.Count == 0 || ctx.BlockHeight()-failures.Height >= window {
+		failures = keysignFailures{Height: ctx.BlockHeight()}
+	}
+	failures.Count++
+	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(failures))
+	return failures.Count, nil
+}
+
+// ResetNodeAccountKeysignFailures - reset the number of keysign failures of
+// the given node address
+func (k KVStore) ResetNodeAccountKeysignFailures(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixKeysignFailures, addr.String())
+	store.Delete([]byte(key))
+}
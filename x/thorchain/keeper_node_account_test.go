@@ -178,3 +178,27 @@ func (s *KeeperNodeAccountSuite) TestNodeAccountSlashPoints(c *C) {
 	c.Assert(err, IsNil)
 	c.Check(pts, Equals, int64(10))
 }
+
+func (s *KeeperNodeAccountSuite) TestNodeAccountKeysignFailures(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	addr := GetRandomBech32Addr()
+
+	count, err := k.IncNodeAccountKeysignFailures(ctx, addr, 10)
+	c.Assert(err, IsNil)
+	c.Check(count, Equals, int64(1))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 9)
+	count, err = k.IncNodeAccountKeysignFailures(ctx, addr, 10)
+	c.Assert(err, IsNil)
+	c.Check(count, Equals, int64(2))
+
+	// the count restarts once the window has passed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	count, err = k.IncNodeAccountKeysignFailures(ctx, addr, 10)
+	c.Assert(err, IsNil)
+	c.Check(count, Equals, int64(1))
+
+	k.ResetNodeAccountKeysignFailures(ctx, addr)
+	count, err = k.IncNodeAccountKeysignFailures(ctx, addr, 10)
+	c.Assert(err, IsNil)
+	c.Check(count, Equals, int64(1))
+}
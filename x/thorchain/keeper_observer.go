@@ -11,6 +11,8 @@ type KeeperObserver interface {
 	GetObservingAddresses(ctx sdk.Context) ([]sdk.AccAddress, error)
 	AddObservingAddresses(ctx sdk.Context, inAddresses []sdk.AccAddress) error
 	ClearObservingAddresses(ctx sdk.Context)
+	GetObserverStats(ctx sdk.Context, addr sdk.AccAddress) (ObserverStats, error)
+	SetObserverStats(ctx sdk.Context, stats ObserverStats)
 }
 
 // SetActiveObserver set the given addr as an active observer address
@@ -91,3 +93,24 @@ func (k KVStore) ClearObservingAddresses(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete([]byte(key))
 }
+
+// GetObserverStats - get the per chain observation stats of the given node address
+func (k KVStore) GetObserverStats(ctx sdk.Context, addr sdk.AccAddress) (ObserverStats, error) {
+	stats := NewObserverStats(addr)
+	key := k.GetKey(ctx, prefixObserverStats, addr.String())
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return stats, nil
+	}
+	if err := k.cdc.UnmarshalBinaryBare(store.Get([]byte(key)), &stats); err != nil {
+		return stats, dbError(ctx, "Unmarshal: observer stats", err)
+	}
+	return stats, nil
+}
+
+// SetObserverStats - save the per chain observation stats of a node address
+func (k KVStore) SetObserverStats(ctx sdk.Context, stats ObserverStats) {
+	key := k.GetKey(ctx, prefixObserverStats, stats.NodeAddress.String())
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(stats))
+}
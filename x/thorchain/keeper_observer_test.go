@@ -3,6 +3,8 @@ package thorchain
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
 )
 
 type KeeperObserverSuite struct{}
@@ -30,3 +32,21 @@ func (s *KeeperObserverSuite) TestObserver(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(addrs, HasLen, 0)
 }
+
+func (s *KeeperObserverSuite) TestObserverStats(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	addr := GetRandomBech32Addr()
+	stats, err := k.GetObserverStats(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Check(stats.NodeAddress.Equals(addr), Equals, true)
+	c.Check(stats.Chains, HasLen, 0)
+
+	stats.AddObservation(common.BNBChain, 100, 2, false)
+	k.SetObserverStats(ctx, stats)
+	stats, err = k.GetObserverStats(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Assert(stats.Chains, HasLen, 1)
+	c.Check(stats.GetChainStats(common.BNBChain).LastObservedHeight, Equals, int64(100))
+	c.Check(stats.GetChainStats(common.BNBChain).TotalDelay, Equals, int64(2))
+}
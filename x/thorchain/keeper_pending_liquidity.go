@@ -0,0 +1,81 @@
+package thorchain
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type KeeperPendingLiquidity interface {
+	GetPendingLiquidityIterator(ctx sdk.Context) sdk.Iterator
+	GetPendingLiquidity(ctx sdk.Context, asset common.Asset, runeAddr, assetAddr common.Address) (PendingLiquidity, error)
+	GetExpiredPendingLiquidity(ctx sdk.Context, height int64) ([]PendingLiquidity, error)
+	SetPendingLiquidity(ctx sdk.Context, pending PendingLiquidity) error
+	RemovePendingLiquidity(ctx sdk.Context, pending PendingLiquidity)
+}
+
+// GetPendingLiquidityIterator iterate all the pending liquidity
+func (k KVStore) GetPendingLiquidityIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, []byte(k.GetKey(ctx, prefixPendingLiquidity, "")))
+}
+
+// GetPendingLiquidity - get the pending liquidity of the given pool and address pair,
+// an empty PendingLiquidity will be returned when there is none
+func (k KVStore) GetPendingLiquidity(ctx sdk.Context, asset common.Asset, runeAddr, assetAddr common.Address) (PendingLiquidity, error) {
+	store := ctx.KVStore(k.storeKey)
+	pending := NewPendingLiquidity(asset, runeAddr, assetAddr)
+	key := k.GetKey(ctx, prefixPendingLiquidity, pending.Key())
+	if !store.Has([]byte(key)) {
+		return pending, nil
+	}
+	if err := k.cdc.UnmarshalBinaryBare(store.Get([]byte(key)), &pending); err != nil {
+		return pending, dbError(ctx, "Unmarshal: pending liquidity", err)
+	}
+	return pending, nil
+}
+
+// GetExpiredPendingLiquidity - get the pending liquidity that expires at or before the given block height, oldest
+// first. It goes through the index of pending liquidity by expiry height, so only the expired records are read
+func (k KVStore) GetExpiredPendingLiquidity(ctx sdk.Context, height int64) ([]PendingLiquidity, error) {
+	store := ctx.KVStore(k.storeKey)
+	start := []byte(k.GetKey(ctx, prefixPendingExpiry, ""))
+	end := []byte(k.GetKey(ctx, prefixPendingExpiry, fmt.Sprintf("%020d", height+1)))
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+	var result []PendingLiquidity
+	for ; iter.Valid(); iter.Next() {
+		var pending PendingLiquidity
+		if err := k.cdc.UnmarshalBinaryBare(store.Get(iter.Value()), &pending); err != nil {
+			return result, dbError(ctx, "Unmarshal: pending liquidity", err)
+		}
+		result = append(result, pending)
+	}
+	return result, nil
+}
+
+func (k KVStore) getPendingLiquidityExpiryKey(ctx sdk.Context, pending PendingLiquidity) []byte {
+	return []byte(k.GetKey(ctx, prefixPendingExpiry, fmt.Sprintf("%020d/%s", pending.ExpireBlockHeight, pending.Key())))
+}
+
+// SetPendingLiquidity - save the pending liquidity
+func (k KVStore) SetPendingLiquidity(ctx sdk.Context, pending PendingLiquidity) error {
+	if err := pending.Valid(); err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixPendingLiquidity, pending.Key())
+	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(pending))
+	store.Set(k.getPendingLiquidityExpiryKey(ctx, pending), []byte(key))
+	return nil
+}
+
+// RemovePendingLiquidity - remove the pending liquidity from the kvstore
+func (k KVStore) RemovePendingLiquidity(ctx sdk.Context, pending PendingLiquidity) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixPendingLiquidity, pending.Key())
+	store.Delete([]byte(key))
+	store.Delete(k.getPendingLiquidityExpiryKey(ctx, pending))
+}
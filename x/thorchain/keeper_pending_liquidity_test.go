@@ -0,0 +1,71 @@
+package thorchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type KeeperPendingLiquiditySuite struct{}
+
+var _ = Suite(&KeeperPendingLiquiditySuite{})
+
+func (s *KeeperPendingLiquiditySuite) TestPendingLiquidity(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	runeAddr := GetRandomBNBAddress()
+	btcAddr := GetRandomBTCAddress()
+	pending, err := k.GetPendingLiquidity(ctx, common.BTCAsset, runeAddr, btcAddr)
+	c.Assert(err, IsNil)
+	c.Assert(pending.IsEmpty(), Equals, true)
+	c.Assert(k.SetPendingLiquidity(ctx, pending), NotNil)
+
+	pending.Add(sdk.NewUint(common.One), sdk.ZeroUint(), GetRandomTx())
+	pending.ExpireBlockHeight = 100
+	c.Assert(k.SetPendingLiquidity(ctx, pending), IsNil)
+	pending, err = k.GetPendingLiquidity(ctx, common.BTCAsset, runeAddr, btcAddr)
+	c.Assert(err, IsNil)
+	c.Check(pending.PendingRune.Equal(sdk.NewUint(common.One)), Equals, true)
+	c.Check(pending.ExpireBlockHeight, Equals, int64(100))
+	c.Check(pending.Txs, HasLen, 1)
+
+	// a different address pair is a different pending stake
+	other, err := k.GetPendingLiquidity(ctx, common.BTCAsset, runeAddr, GetRandomBTCAddress())
+	c.Assert(err, IsNil)
+	c.Check(other.IsEmpty(), Equals, true)
+
+	// looked up by expiry height
+	later := NewPendingLiquidity(common.BTCAsset, GetRandomBNBAddress(), GetRandomBTCAddress())
+	later.Add(sdk.NewUint(common.One), sdk.ZeroUint(), GetRandomTx())
+	later.ExpireBlockHeight = 101
+	c.Assert(k.SetPendingLiquidity(ctx, later), IsNil)
+	expired, err := k.GetExpiredPendingLiquidity(ctx, 99)
+	c.Assert(err, IsNil)
+	c.Check(expired, HasLen, 0)
+	expired, err = k.GetExpiredPendingLiquidity(ctx, 100)
+	c.Assert(err, IsNil)
+	c.Assert(expired, HasLen, 1)
+	c.Check(expired[0].AssetAddress.Equals(btcAddr), Equals, true)
+	expired, err = k.GetExpiredPendingLiquidity(ctx, 1000)
+	c.Assert(err, IsNil)
+	c.Assert(expired, HasLen, 2)
+	c.Check(expired[1].AssetAddress.Equals(later.AssetAddress), Equals, true)
+	k.RemovePendingLiquidity(ctx, later)
+	expired, err = k.GetExpiredPendingLiquidity(ctx, 1000)
+	c.Assert(err, IsNil)
+	c.Check(expired, HasLen, 1)
+
+	count := 0
+	iter := k.GetPendingLiquidityIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	iter.Close()
+	c.Check(count, Equals, 1)
+
+	k.RemovePendingLiquidity(ctx, pending)
+	pending, err = k.GetPendingLiquidity(ctx, common.BTCAsset, runeAddr, btcAddr)
+	c.Assert(err, IsNil)
+	c.Check(pending.IsEmpty(), Equals, true)
+}
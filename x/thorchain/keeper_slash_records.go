@@ -0,0 +1,103 @@
+package thorchain
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxSlashRecords is the number of slash records kept for each node account, older records are dropped
+const maxSlashRecords = 100
+
+// slashRecordMergeBlocks is the number of blocks within which a slash record
+// is merged into the previous record of the same reason, when the reason is
+// aggregated (see SlashReason.IsAggregated)
+const slashRecordMergeBlocks = 600
+
+type KeeperSlashRecords interface {
+	AddNodeAccountSlashRecord(_ sdk.Context, _ sdk.AccAddress, _ SlashRecord) error
+	GetNodeAccountSlashRecords(_ sdk.Context, _ sdk.AccAddress) (SlashRecords, error)
+}
+
+// slashRecordIndex is the range of sequence numbers of the slash records
+// kept for a node account, First is the oldest record, Next is the sequence
+// number of the next record
+type slashRecordIndex struct {
+	First int64 `json:"first"`
+	Next  int64 `json:"next"`
+}
+
+func (k KVStore) getSlashRecordKey(ctx sdk.Context, addr sdk.AccAddress, seq int64) []byte {
+	return []byte(k.GetKey(ctx, prefixNodeSlashRecords, fmt.Sprintf("%s/%d", addr, seq)))
+}
+
+func (k KVStore) getSlashRecordIndex(ctx sdk.Context, addr sdk.AccAddress) (slashRecordIndex, error) {
+	var index slashRecordIndex
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixSlashRecordIndex, addr.String())
+	if !store.Has([]byte(key)) {
+		return index, nil
+	}
+	if err := k.cdc.UnmarshalBinaryBare(store.Get([]byte(key)), &index); err != nil {
+		return index, dbError(ctx, "Unmarshal: node account slash record index", err)
+	}
+	return index, nil
+}
+
+func (k KVStore) getSlashRecord(ctx sdk.Context, addr sdk.AccAddress, seq int64) (SlashRecord, error) {
+	var record SlashRecord
+	store := ctx.KVStore(k.storeKey)
+	if err := k.cdc.UnmarshalBinaryBare(store.Get(k.getSlashRecordKey(ctx, addr, seq)), &record); err != nil {
+		return record, dbError(ctx, "Unmarshal: node account slash record", err)
+	}
+	return record, nil
+}
+
+// AddNodeAccountSlashRecord - add a slash record to the slash ledger of the
+// given node address. A record of an aggregated reason is merged into the
+// latest record when it has the same reason and was last updated within
+// slashRecordMergeBlocks, so slashes that happen every block don't push the
+// other records out of the ledger
+func (k KVStore) AddNodeAccountSlashRecord(ctx sdk.Context, addr sdk.AccAddress, record SlashRecord) error {
+	index, err := k.getSlashRecordIndex(ctx, addr)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	if record.Reason.IsAggregated() && index.Next > index.First {
+		last, err := k.getSlashRecord(ctx, addr, index.Next-1)
+		if err != nil {
+			return err
+		}
+		if last.Reason == record.Reason && record.Height-last.EndHeight <= slashRecordMergeBlocks {
+			last.Merge(record)
+			store.Set(k.getSlashRecordKey(ctx, addr, index.Next-1), k.cdc.MustMarshalBinaryBare(last))
+			return nil
+		}
+	}
+	store.Set(k.getSlashRecordKey(ctx, addr, index.Next), k.cdc.MustMarshalBinaryBare(record))
+	index.Next++
+	for index.Next-index.First > maxSlashRecords {
+		store.Delete(k.getSlashRecordKey(ctx, addr, index.First))
+		index.First++
+	}
+	store.Set([]byte(k.GetKey(ctx, prefixSlashRecordIndex, addr.String())), k.cdc.MustMarshalBinaryBare(index))
+	return nil
+}
+
+// GetNodeAccountSlashRecords - get the slash ledger of the given node address
+func (k KVStore) GetNodeAccountSlashRecords(ctx sdk.Context, addr sdk.AccAddress) (SlashRecords, error) {
+	index, err := k.getSlashRecordIndex(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	var records SlashRecords
+	for seq := index.First; seq < index.Next; seq++ {
+		record, err := k.getSlashRecord(ctx, addr, seq)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
@@ -0,0 +1,53 @@
+package thorchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+)
+
+type KeeperSlashRecordsSuite struct{}
+
+var _ = Suite(&KeeperSlashRecordsSuite{})
+
+func (s *KeeperSlashRecordsSuite) TestSlashRecords(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	addr := GetRandomBech32Addr()
+
+	records, err := k.GetNodeAccountSlashRecords(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Check(records, HasLen, 0)
+
+	c.Assert(k.AddNodeAccountSlashRecord(ctx, addr, NewSlashRecord(1, SlashReasonLackOfObservation, 2, sdk.ZeroUint())), IsNil)
+	c.Assert(k.AddNodeAccountSlashRecord(ctx, addr, NewSlashRecord(2, SlashReasonExcessOutbound, 0, sdk.NewUint(100))), IsNil)
+	records, err = k.GetNodeAccountSlashRecords(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 2)
+	c.Check(records[0].Reason, Equals, SlashReasonLackOfObservation)
+	c.Check(records[1].Bond.Equal(sdk.NewUint(100)), Equals, true)
+
+	// lack of observation slashes are merged into the latest record
+	for i := int64(3); i < 10; i++ {
+		c.Assert(k.AddNodeAccountSlashRecord(ctx, addr, NewSlashRecord(i, SlashReasonLackOfObservation, 2, sdk.ZeroUint())), IsNil)
+	}
+	records, err = k.GetNodeAccountSlashRecords(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 3)
+	c.Check(records[2].Height, Equals, int64(3))
+	c.Check(records[2].EndHeight, Equals, int64(9))
+	c.Check(records[2].Count, Equals, int64(7))
+	c.Check(records[2].SlashPoints, Equals, int64(14))
+	// a new record is started after a gap
+	c.Assert(k.AddNodeAccountSlashRecord(ctx, addr, NewSlashRecord(10+slashRecordMergeBlocks, SlashReasonLackOfObservation, 2, sdk.ZeroUint())), IsNil)
+	records, err = k.GetNodeAccountSlashRecords(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 4)
+
+	// only the most recent records are kept
+	for i := 0; i < maxSlashRecords; i++ {
+		c.Assert(k.AddNodeAccountSlashRecord(ctx, addr, NewSlashRecord(int64(i+1000), SlashReasonFailKeysign, 2, sdk.ZeroUint())), IsNil)
+	}
+	records, err = k.GetNodeAccountSlashRecords(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, maxSlashRecords)
+	c.Check(records[0].Height, Equals, int64(1000))
+}
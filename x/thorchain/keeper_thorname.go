@@ -0,0 +1,38 @@
+package thorchain
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type KeeperTHORName interface {
+	GetTHORName(ctx sdk.Context, name string) (THORName, error)
+	SetTHORName(ctx sdk.Context, name THORName) error
+}
+
+// GetTHORName - get the THORName with the given name, names are case insensitive,
+// an empty THORName will be returned when there is none
+func (k KVStore) GetTHORName(ctx sdk.Context, name string) (THORName, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixTHORName, strings.ToLower(name))
+	if !store.Has([]byte(key)) {
+		return THORName{}, nil
+	}
+	var tn THORName
+	if err := k.cdc.UnmarshalBinaryBare(store.Get([]byte(key)), &tn); err != nil {
+		return THORName{}, dbError(ctx, "Unmarshal: thorname", err)
+	}
+	return tn, nil
+}
+
+// SetTHORName - save a THORName
+func (k KVStore) SetTHORName(ctx sdk.Context, name THORName) error {
+	if err := name.Valid(); err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetKey(ctx, prefixTHORName, strings.ToLower(name.Name))
+	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(name))
+	return nil
+}
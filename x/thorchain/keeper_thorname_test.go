@@ -0,0 +1,32 @@
+package thorchain
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type KeeperTHORNameSuite struct{}
+
+var _ = Suite(&KeeperTHORNameSuite{})
+
+func (s *KeeperTHORNameSuite) TestTHORName(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	tn, err := k.GetTHORName(ctx, "bob")
+	c.Assert(err, IsNil)
+	c.Assert(tn.IsEmpty(), Equals, true)
+
+	c.Assert(k.SetTHORName(ctx, THORName{}), NotNil)
+	owner := GetRandomBech32Addr()
+	bnbAddr := GetRandomBNBAddress()
+	tn = NewTHORName("bob", 100, owner)
+	tn.SetAlias(common.BNBChain, bnbAddr)
+	c.Assert(k.SetTHORName(ctx, tn), IsNil)
+	tn, err = k.GetTHORName(ctx, "BOB")
+	c.Assert(err, IsNil)
+	c.Check(tn.Name, Equals, "bob")
+	c.Check(tn.Owner.Equals(owner), Equals, true)
+	c.Check(tn.ExpireBlockHeight, Equals, int64(100))
+	c.Check(tn.GetAlias(common.BNBChain).Equals(bnbAddr), Equals, true)
+}
@@ -0,0 +1,296 @@
+package memo
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type MemoSuite struct{}
+
+var _ = Suite(&MemoSuite{})
+
+func (s *MemoSuite) SetUpSuite(c *C) {
+	types.SetupConfigForTest()
+}
+
+func (s *MemoSuite) TestTxType(c *C) {
+	for _, trans := range []TxType{TxStake, TxUnstake, TxSwap, TxOutbound, TxAdd, TxBond, TxUnbond, TxLeave, TxSwitch} {
+		tx, err := StringToTxType(trans.String())
+		c.Assert(err, IsNil)
+		c.Check(tx, Equals, trans)
+	}
+}
+
+func (s *MemoSuite) TestParseWithAbbreviated(c *C) {
+	// happy paths
+	memo, err := ParseMemo("%:BNB.RUNE-1BA")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetAsset().String(), Equals, "BNB.RUNE-1BA")
+	c.Check(memo.IsType(TxAdd), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.IsInbound(), Equals, true)
+
+	memo, err = ParseMemo("+:BNB.RUNE-1BA")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetAsset().String(), Equals, "BNB.RUNE-1BA")
+	c.Check(memo.IsType(TxStake), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.IsInbound(), Equals, true)
+
+	memo, err = ParseMemo("-:BNB.RUNE-1BA:25")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetAsset().String(), Equals, "BNB.RUNE-1BA")
+	c.Check(memo.IsType(TxUnstake), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.GetAmount(), Equals, "25")
+	c.Check(memo.IsInbound(), Equals, true)
+
+	memo, err = ParseMemo("=:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetAsset().String(), Equals, "BNB.RUNE-1BA")
+	c.Check(memo.IsType(TxSwap), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.GetDestination().String(), Equals, "bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6")
+	c.Log(memo.GetSlipLimit().Uint64())
+	c.Check(memo.GetSlipLimit().Equal(sdk.NewUint(870000000)), Equals, true)
+	c.Check(memo.IsInbound(), Equals, true)
+
+	memo, err = ParseMemo("=:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetAsset().String(), Equals, "BNB.RUNE-1BA")
+	c.Check(memo.IsType(TxSwap), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.GetDestination().String(), Equals, "bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6")
+	c.Check(memo.GetSlipLimit().Uint64(), Equals, uint64(0))
+	c.Check(memo.IsInbound(), Equals, true)
+
+	memo, err = ParseMemo("=:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetAsset().String(), Equals, "BNB.RUNE-1BA")
+	c.Check(memo.IsType(TxSwap), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.GetDestination().String(), Equals, "bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6")
+	c.Check(memo.GetSlipLimit().Equal(sdk.ZeroUint()), Equals, true)
+
+	memo, err = ParseMemo("OUTBOUND:MUKVQILIHIAUSEOVAXBFEZAJKYHFJYHRUUYGQJZGFYBYVXCXYNEMUOAIQKFQLLCX")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxOutbound), Equals, true, Commentf("%s", memo.GetType()))
+	c.Check(memo.IsOutbound(), Equals, true)
+
+	memo, err = ParseMemo("REFUND:MUKVQILIHIAUSEOVAXBFEZAJKYHFJYHRUUYGQJZGFYBYVXCXYNEMUOAIQKFQLLCX")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxRefund), Equals, true)
+	c.Check(memo.IsOutbound(), Equals, true)
+
+	memo, err = ParseMemo("leave:whatever")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxLeave), Equals, true)
+
+	memo, err = ParseMemo("yggdrasil+:30")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxYggdrasilFund), Equals, true)
+	c.Check(memo.IsInternal(), Equals, true)
+	memo, err = ParseMemo("yggdrasil-:30")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxYggdrasilReturn), Equals, true)
+	c.Check(memo.IsInternal(), Equals, true)
+	memo, err = ParseMemo("migrate:100")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxMigrate), Equals, true)
+	c.Check(memo.IsInternal(), Equals, true)
+
+	memo, err = ParseMemo("ragnarok:100")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxRagnarok), Equals, true)
+	c.Check(memo.IsInternal(), Equals, true)
+
+	memo, err = ParseMemo("consolidate")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxConsolidate), Equals, true)
+	c.Check(memo.IsInternal(), Equals, true)
+	c.Check(memo.String(), Equals, "CONSOLIDATE")
+
+	mem := fmt.Sprintf("switch:%s", types.GetRandomBech32Addr())
+	memo, err = ParseMemo(mem)
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxSwitch), Equals, true)
+	c.Check(memo.IsInbound(), Equals, true)
+
+	// unhappy paths
+	_, err = ParseMemo("")
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("bogus")
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("CREATE") // missing symbol
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("c:") // bad symbol
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("-:bnb") // withdraw basis points is optional
+	c.Assert(err, IsNil)
+	_, err = ParseMemo("-:bnb:twenty-two") // bad amount
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("=:bnb:bad_DES:5.6") // bad destination
+	c.Assert(err, NotNil)
+	_, err = ParseMemo(">:bnb:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:five") // bad slip limit
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("!:key:val") // not enough arguments
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("!:bogus:key:value") // bogus admin command type
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("nextpool:whatever")
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("migrate")
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("switch")
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("switch:")
+	c.Assert(err, NotNil)
+}
+
+func (s *MemoSuite) TestParse(c *C) {
+	// happy paths
+	memo, err := ParseMemo("add:BNB.RUNE-1BA")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetAsset().String(), Equals, "BNB.RUNE-1BA")
+	c.Check(memo.IsType(TxAdd), Equals, true, Commentf("MEMO: %+v", memo))
+
+	memo, err = ParseMemo("STAKE:BNB.RUNE-1BA")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetAsset().String(), Equals, "BNB.RUNE-1BA")
+	c.Check(memo.IsType(TxStake), Equals, true, Commentf("MEMO: %+v", memo))
+
+	memo, err = ParseMemo("STAKE:BTC.BTC")
+	c.Assert(err, NotNil)
+	memo, err = ParseMemo("STAKE:BTC.BTC:bc1qwqdg6squsna38e46795at95yu9atm8azzmyvckulcc7kytlcckxswvvzej")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetDestination().String(), Equals, "bc1qwqdg6squsna38e46795at95yu9atm8azzmyvckulcc7kytlcckxswvvzej")
+	c.Check(memo.IsType(TxStake), Equals, true, Commentf("MEMO: %+v", memo))
+
+	memo, err = ParseMemo("WITHDRAW:BNB.RUNE-1BA:25")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetAsset().String(), Equals, "BNB.RUNE-1BA")
+	c.Check(memo.IsType(TxUnstake), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.GetAmount(), Equals, "25")
+
+	memo, err = ParseMemo("SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetAsset().String(), Equals, "BNB.RUNE-1BA")
+	c.Check(memo.IsType(TxSwap), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.GetDestination().String(), Equals, "bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6")
+	c.Log(memo.GetSlipLimit().String())
+	c.Check(memo.GetSlipLimit().Equal(sdk.NewUint(870000000)), Equals, true)
+
+	memo, err = ParseMemo("SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetAsset().String(), Equals, "BNB.RUNE-1BA")
+	c.Check(memo.IsType(TxSwap), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.GetDestination().String(), Equals, "bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6")
+	c.Check(memo.GetSlipLimit().Uint64(), Equals, uint64(0))
+
+	memo, err = ParseMemo("SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetAsset().String(), Equals, "BNB.RUNE-1BA")
+	c.Check(memo.IsType(TxSwap), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.GetDestination().String(), Equals, "bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6")
+	c.Check(memo.GetSlipLimit().Uint64(), Equals, uint64(0))
+
+	memo, err = ParseMemo("SWAP:BNB.RUNE-1BA:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6::bnb18jtza8j86hfyuj2f90zec0g5gvjh823e5psn2u:50")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetSlipLimit().Uint64(), Equals, uint64(0))
+	c.Check(memo.(SwapMemo).AffiliateAddress.String(), Equals, "bnb18jtza8j86hfyuj2f90zec0g5gvjh823e5psn2u")
+	c.Check(memo.(SwapMemo).AffiliateBasisPoints.Uint64(), Equals, uint64(50))
+
+	whiteListAddr := types.GetRandomBech32Addr()
+	memo, err = ParseMemo("bond:" + whiteListAddr.String())
+	c.Assert(err, IsNil)
+	c.Assert(memo.IsType(TxBond), Equals, true)
+	c.Assert(memo.GetAccAddress().String(), Equals, whiteListAddr.String())
+	c.Assert(memo.(BondMemo).BondProviderAddress.IsEmpty(), Equals, true)
+	c.Assert(memo.(BondMemo).OperatorFee, Equals, int64(-1))
+
+	providerAddr := types.GetRandomBNBAddress()
+	memo, err = ParseMemo("bond:" + whiteListAddr.String() + ":" + providerAddr.String() + ":2000")
+	c.Assert(err, IsNil)
+	c.Assert(memo.IsType(TxBond), Equals, true)
+	c.Assert(memo.(BondMemo).BondProviderAddress.Equals(providerAddr), Equals, true)
+	c.Assert(memo.(BondMemo).OperatorFee, Equals, int64(2000))
+	_, err = ParseMemo("bond:" + whiteListAddr.String() + ":" + providerAddr.String() + ":10001")
+	c.Assert(err, NotNil)
+
+	memo, err = ParseMemo("leave")
+	c.Assert(err, IsNil)
+	c.Assert(memo.IsType(TxLeave), Equals, true)
+
+	memo, err = ParseMemo("unbond:100000000")
+	c.Assert(err, IsNil)
+	c.Assert(memo.IsType(TxUnbond), Equals, true)
+	c.Assert(memo.IsInbound(), Equals, true)
+	c.Assert(memo.GetAmount(), Equals, "100000000")
+	c.Assert(memo.GetAccAddress().Empty(), Equals, true)
+	memo, err = ParseMemo("unbond:100000000:" + whiteListAddr.String())
+	c.Assert(err, IsNil)
+	c.Assert(memo.GetAccAddress().String(), Equals, whiteListAddr.String())
+	_, err = ParseMemo("unbond")
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("unbond:0")
+	c.Assert(err, NotNil)
+	bondProvider := types.GetRandomBNBAddress()
+	memo, err = ParseMemo("unbond:0:" + whiteListAddr.String() + ":" + bondProvider.String())
+	c.Assert(err, IsNil)
+	c.Assert(memo.(UnbondMemo).BondProviderAddress.Equals(bondProvider), Equals, true)
+	_, err = ParseMemo("unbond:abc")
+	c.Assert(err, NotNil)
+
+	memo, err = ParseMemo("migrate:100")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxMigrate), Equals, true)
+
+	// unhappy paths
+	_, err = ParseMemo("")
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("bogus")
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("CREATE") // missing symbol
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("CREATE:") // bad symbol
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("withdraw:bnb") // withdraw basis points is optional
+	c.Assert(err, IsNil)
+	_, err = ParseMemo("withdraw:bnb:twenty-two") // bad amount
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("swap:bnb:STAKER-1:5.6") // bad destination
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("swap:bnb:bad_DES:5.6") // bad destination
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("swap:bnb:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:five") // bad slip limit
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("swap:bnb:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6::bad_AFF:50") // bad affiliate address
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("swap:bnb:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6::bnb18jtza8j86hfyuj2f90zec0g5gvjh823e5psn2u:1001") // affiliate fee too high
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("swap:bnb:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6::bnb18jtza8j86hfyuj2f90zec0g5gvjh823e5psn2u") // affiliate fee missing
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("swap:bnb:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:::50") // affiliate address missing
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("admin:key:val") // not enough arguments
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("admin:bogus:key:value") // bogus admin command type
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("migrate:abc")
+	c.Assert(err, NotNil)
+}
+
+func (s *MemoSuite) TestValidateMemo(c *C) {
+	c.Check(ValidateMemo("SWAP:BNB.BNB:"+types.GetRandomBNBAddress().String()), IsNil)
+	c.Check(ValidateMemo("STAKE:BNB.BNB"), IsNil)
+	// memo references and THORNames are resolved by THORChain
+	c.Check(ValidateMemo("REF:12"), IsNil)
+	c.Check(ValidateMemo("SWAP:BTC.BTC:bob"), IsNil)
+
+	c.Check(ValidateMemo(""), NotNil)
+	c.Check(ValidateMemo("SWAP"), NotNil)
+	c.Check(ValidateMemo("bogus:BNB.BNB"), NotNil)
+	c.Check(ValidateMemo("SWAP:BNB.BNB:bob:alice"), NotNil)
+	c.Check(ValidateMemo("SWITCH:bob alice"), NotNil)
+}
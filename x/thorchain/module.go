@@ -151,7 +151,7 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	if err != nil {
 		ctx.Logger().Error("fail to create slasher", "error", err)
 	}
-	slasher.BeginBlock(ctx, req, constantValues)
+	slasher.BeginBlock(ctx, req, constantValues, am.validatorMgr)
 
 	if err := am.validatorMgr.BeginBlock(ctx, version, constantValues); err != nil {
 		ctx.Logger().Error("Fail to begin block on validator", "error", err)
@@ -205,6 +205,9 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 	if err := slasher.LackSigning(ctx, constantValues, txStore); err != nil {
 		ctx.Logger().Error("Unable to slash for lack of signing:", "error", err)
 	}
+	if err := slasher.DecaySlashPoints(ctx, constantValues); err != nil {
+		ctx.Logger().Error("Unable to decay slash points:", "error", err)
+	}
 	newPoolCycle := constantValues.GetInt64Value(constants.NewPoolCycle)
 	// Enable a pool every newPoolCycle
 	if ctx.BlockHeight()%newPoolCycle == 0 {
@@ -212,6 +215,8 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 			ctx.Logger().Error("Unable to enable a pool", "error", err)
 		}
 	}
+	// stake or refund the cross chain stakes that never got their other side
+	processExpiredPendingLiquidity(ctx, am.keeper, txStore, eventMgr, constantValues)
 
 	// fail stale pending events
 	signingTransPeriod := constantValues.GetInt64Value(constants.SigningTransactionPeriod)
@@ -84,3 +84,15 @@ func (om *ObserverMgr) EndBlock(ctx sdk.Context, keeper Keeper) {
 		ctx.Logger().Error("fail to append observers", "error", err)
 	}
 }
+
+// recordObservation update the observation stats of the given node address
+// on the chain of the observed tx
+func recordObservation(ctx sdk.Context, keeper Keeper, addr sdk.AccAddress, tx ObservedTx, delay int64, missedConsensus bool) {
+	stats, err := keeper.GetObserverStats(ctx, addr)
+	if err != nil {
+		ctx.Logger().Error("fail to get observer stats", "error", err)
+		return
+	}
+	stats.AddObservation(tx.Tx.Chain, tx.BlockHeight, delay, missedConsensus)
+	keeper.SetObserverStats(ctx, stats)
+}
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 
@@ -48,6 +50,8 @@ func NewQuerier(keeper Keeper, validatorMgr VersionedValidatorManager) sdk.Queri
 			return queryObservers(ctx, path[1:], req, keeper)
 		case q.QueryObserver.Key:
 			return queryObserver(ctx, path[1:], req, keeper)
+		case q.QueryObserverStats.Key:
+			return queryObserverStats(ctx, path[1:], req, keeper)
 		case q.QueryNodeAccount.Key:
 			return queryNodeAccount(ctx, path[1:], req, keeper)
 		case q.QueryNodeAccounts.Key:
@@ -59,9 +63,11 @@ func NewQuerier(keeper Keeper, validatorMgr VersionedValidatorManager) sdk.Queri
 		case q.QueryVaultsAsgard.Key:
 			return queryAsgardVaults(ctx, keeper)
 		case q.QueryVaultsYggdrasil.Key:
-			return queryYggdrasilVaults(ctx, keeper)
+			return queryYggdrasilVaults(ctx, req, keeper)
 		case q.QueryVaultPubkeys.Key:
 			return queryVaultsPubkeys(ctx, keeper)
+		case q.QueryVaultMigrations.Key:
+			return queryVaultMigrations(ctx, keeper)
 		case q.QueryTSSSigners.Key:
 			return queryTSSSigners(ctx, path[1:], req, keeper)
 		case q.QueryConstantValues.Key:
@@ -70,6 +76,18 @@ func NewQuerier(keeper Keeper, validatorMgr VersionedValidatorManager) sdk.Queri
 			return queryMimirValues(ctx, path[1:], req, keeper)
 		case q.QueryBan.Key:
 			return queryBan(ctx, path[1:], req, keeper)
+		case q.QuerySlashes.Key:
+			return querySlashes(ctx, path[1:], req, keeper)
+		case q.QueryNetworkFee.Key:
+			return queryNetworkFee(ctx, path[1:], req, keeper)
+		case q.QueryMemoRef.Key:
+			return queryMemoRef(ctx, path[1:], req, keeper)
+		case q.QueryTHORName.Key:
+			return queryTHORName(ctx, path[1:], req, keeper)
+		case q.QueryPendingLiquidity.Key:
+			return queryPendingLiquidity(ctx, keeper)
+		case q.QueryOutboundQueue.Key:
+			return queryOutboundQueue(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest(
 				fmt.Sprintf("unknown thorchain query endpoint: %s", path[0]),
@@ -90,6 +108,92 @@ func getURLFromData(data []byte) (*url.URL, error) {
 	return u, nil
 }
 
+// getQueryValues returns the query string params sent along with the query,
+// there are none when the query wasn't made through the rest api
+func getQueryValues(req abci.RequestQuery) (url.Values, sdk.Error) {
+	if req.Data == nil {
+		return url.Values{}, nil
+	}
+	u, err := getURLFromData(req.Data)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	return u.Query(), nil
+}
+
+// queryPagination is the page of a collection a query asks for with the
+// `limit` and `offset` query string params, a zero limit means no limit
+type queryPagination struct {
+	Limit  int
+	Offset int
+}
+
+func getPaginationFromQuery(values url.Values) (queryPagination, sdk.Error) {
+	var p queryPagination
+	var err error
+	if limit := values.Get("limit"); limit != "" {
+		p.Limit, err = strconv.Atoi(limit)
+		if err != nil || p.Limit < 0 {
+			return p, sdk.ErrUnknownRequest(fmt.Sprintf("invalid limit: %s", limit))
+		}
+	}
+	if offset := values.Get("offset"); offset != "" {
+		p.Offset, err = strconv.Atoi(offset)
+		if err != nil || p.Offset < 0 {
+			return p, sdk.ErrUnknownRequest(fmt.Sprintf("invalid offset: %s", offset))
+		}
+	}
+	return p, nil
+}
+
+// Page returns the start and end index of the page in a collection of the given size
+func (p queryPagination) Page(total int) (int, int) {
+	start := p.Offset
+	if start > total {
+		start = total
+	}
+	end := total
+	if p.Limit > 0 && start+p.Limit < end {
+		end = start + p.Limit
+	}
+	return start, end
+}
+
+// getHeightRangeFromQuery returns the block height range a query asks for with
+// the `from_height` and `to_height` query string params, both are inclusive
+// and zero when not set
+func getHeightRangeFromQuery(values url.Values) (int64, int64, sdk.Error) {
+	var from, to int64
+	var err error
+	if value := values.Get("from_height"); value != "" {
+		from, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, 0, sdk.ErrUnknownRequest(fmt.Sprintf("invalid from_height: %s", value))
+		}
+	}
+	if value := values.Get("to_height"); value != "" {
+		to, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, 0, sdk.ErrUnknownRequest(fmt.Sprintf("invalid to_height: %s", value))
+		}
+	}
+	return from, to, nil
+}
+
+// getNodeStatusFromQuery returns the node status a query filters on with the
+// `status` query string param, NodeUnknown when not set
+func getNodeStatusFromQuery(values url.Values) (NodeStatus, sdk.Error) {
+	value := values.Get("status")
+	if value == "" {
+		return NodeUnknown, nil
+	}
+	status := GetNodeStatus(value)
+	if status == NodeUnknown {
+		return NodeUnknown, sdk.ErrUnknownRequest(fmt.Sprintf("invalid node status: %s", value))
+	}
+	return status, nil
+}
+
 func queryAsgardVaults(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
 	vaults, err := keeper.GetAsgardVaults(ctx)
 	if err != nil {
@@ -113,7 +217,20 @@ func queryAsgardVaults(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
 	return res, nil
 }
 
-func queryYggdrasilVaults(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+func queryYggdrasilVaults(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	values, qErr := getQueryValues(req)
+	if qErr != nil {
+		return nil, qErr
+	}
+	pagination, qErr := getPaginationFromQuery(values)
+	if qErr != nil {
+		return nil, qErr
+	}
+	status, qErr := getNodeStatusFromQuery(values)
+	if qErr != nil {
+		return nil, qErr
+	}
+
 	vaults := make(Vaults, 0)
 	iter := keeper.GetVaultIterator(ctx)
 	defer iter.Close()
@@ -128,16 +245,29 @@ func queryYggdrasilVaults(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
 		}
 	}
 
-	respVaults := make([]QueryYggdrasilVaults, len(vaults))
-	for i, vault := range vaults {
-		totalValue := sdk.ZeroUint()
-
-		// find the bond of this node account
+	// find the node account of each vault, to filter on its status
+	nodeAccounts := make(NodeAccounts, 0, len(vaults))
+	filtered := make(Vaults, 0, len(vaults))
+	for _, vault := range vaults {
 		na, err := keeper.GetNodeAccountByPubKey(ctx, vault.PubKey)
 		if err != nil {
 			ctx.Logger().Error("fail to get node account by pubkey", "error", err)
 			continue
 		}
+		if status != NodeUnknown && na.Status != status {
+			continue
+		}
+		nodeAccounts = append(nodeAccounts, na)
+		filtered = append(filtered, vault)
+	}
+	start, end := pagination.Page(len(filtered))
+	vaults = filtered[start:end]
+	nodeAccounts = nodeAccounts[start:end]
+
+	respVaults := make([]QueryYggdrasilVaults, len(vaults))
+	for i, vault := range vaults {
+		totalValue := sdk.ZeroUint()
+		na := nodeAccounts[i]
 
 		// calculate the total value of this yggdrasil vault
 		for _, coin := range vault.Coins {
@@ -198,6 +328,52 @@ func queryVaultsPubkeys(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
 	return res, nil
 }
 
+func queryVaultMigrations(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	retiring, err := keeper.GetAsgardVaultsByStatus(ctx, RetiringVault)
+	if err != nil {
+		ctx.Logger().Error("fail to get retiring asgard vaults", "error", err)
+		return nil, sdk.ErrInternal("fail to get retiring asgard vaults")
+	}
+	constAccessor := constants.GetConstantValues(keeper.GetLowestActiveVersion(ctx))
+	if constAccessor == nil {
+		// no active node accounts
+		constAccessor = constants.GetConstantValues(constants.SWVersion)
+	}
+	migrateInterval, err := keeper.GetMimir(ctx, constants.FundMigrationInterval.String())
+	if migrateInterval < 0 || err != nil {
+		migrateInterval = constAccessor.GetInt64Value(constants.FundMigrationInterval)
+	}
+
+	migrations := make([]QueryResMigration, 0)
+	for _, vault := range retiring {
+		plan, err := keeper.GetMigrationPlan(ctx, vault.PubKey)
+		if err != nil {
+			ctx.Logger().Error("fail to get migration plan", "error", err)
+			return nil, sdk.ErrInternal("fail to get migration plan")
+		}
+		if plan.IsEmpty() {
+			// no migration tx had been issued yet
+			plan = NewMigrationPlan(vault.StatusSince, vault)
+		}
+		migration := QueryResMigration{
+			Plan:       plan,
+			Remaining:  vault.Coins,
+			PendingTxs: vault.LenPendingTxBlockHeights(ctx.BlockHeight(), constAccessor),
+		}
+		if migrateInterval > 0 {
+			migration.NextRoundHeight = vault.StatusSince + ((ctx.BlockHeight()-vault.StatusSince)/migrateInterval+1)*migrateInterval
+		}
+		migrations = append(migrations, migration)
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), migrations)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal migrations to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal migrations to json")
+	}
+	return res, nil
+}
+
 func queryVaultData(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
 	data, err := keeper.GetVaultData(ctx)
 	if err != nil {
@@ -234,9 +410,12 @@ func queryPoolAddresses(ctx sdk.Context, path []string, req abci.RequestQuery, k
 		Current []address `json:"current"`
 	}
 
-	if len(active) > 0 {
-		// select vault with lowest amount of rune
-		vault := active.SelectByMinCoin(common.RuneAsset())
+	// list all active asgard vaults, the vault with lowest amount of rune
+	// first, so clients only reading the first address keep working
+	sort.SliceStable(active, func(i, j int) bool {
+		return active[i].GetCoin(common.RuneAsset()).Amount.LT(active[j].GetCoin(common.RuneAsset()).Amount)
+	})
+	for _, vault := range active {
 		chains := vault.Chains
 
 		if len(chains) == 0 {
@@ -288,6 +467,7 @@ func queryNodeAccount(ctx sdk.Context, path []string, req abci.RequestQuery, kee
 
 	result := NewQueryNodeAccount(nodeAcc)
 	result.SlashPoints = slashPts
+	result.Jailed = nodeAcc.IsJailed(ctx.BlockHeight())
 	res, err := codec.MarshalJSONIndent(keeper.Cdc(), result)
 	if err != nil {
 		ctx.Logger().Error("fail to marshal node account to json", "error", err)
@@ -298,10 +478,34 @@ func queryNodeAccount(ctx sdk.Context, path []string, req abci.RequestQuery, kee
 }
 
 func queryNodeAccounts(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	values, qErr := getQueryValues(req)
+	if qErr != nil {
+		return nil, qErr
+	}
+	pagination, qErr := getPaginationFromQuery(values)
+	if qErr != nil {
+		return nil, qErr
+	}
+	status, qErr := getNodeStatusFromQuery(values)
+	if qErr != nil {
+		return nil, qErr
+	}
+
 	nodeAccounts, err := keeper.ListNodeAccountsWithBond(ctx)
 	if err != nil {
 		return nil, sdk.ErrInternal("fail to get node accounts")
 	}
+	if status != NodeUnknown {
+		filtered := make(NodeAccounts, 0, len(nodeAccounts))
+		for _, na := range nodeAccounts {
+			if na.Status == status {
+				filtered = append(filtered, na)
+			}
+		}
+		nodeAccounts = filtered
+	}
+	start, end := pagination.Page(len(nodeAccounts))
+	nodeAccounts = nodeAccounts[start:end]
 
 	result := make([]QueryNodeAccount, len(nodeAccounts))
 	for i, na := range nodeAccounts {
@@ -311,6 +515,7 @@ func queryNodeAccounts(ctx sdk.Context, path []string, req abci.RequestQuery, ke
 		}
 		result[i] = NewQueryNodeAccount(na)
 		result[i].SlashPoints = slashPts
+		result[i].Jailed = na.IsJailed(ctx.BlockHeight())
 	}
 
 	res, err := codec.MarshalJSONIndent(keeper.Cdc(), result)
@@ -361,6 +566,46 @@ func queryObserver(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 	return res, nil
 }
 
+func queryObserverStats(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	observerAddr := path[0]
+	addr, err := sdk.AccAddressFromBech32(observerAddr)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest("invalid account address")
+	}
+
+	stats, err := keeper.GetObserverStats(ctx, addr)
+	if err != nil {
+		ctx.Logger().Error("fail to get observer stats", "error", err)
+		return nil, sdk.ErrInternal("fail to get observer stats")
+	}
+	result := QueryResObserverStats{
+		NodeAddress: addr,
+		Chains:      make([]QueryObserverChain, 0, len(stats.Chains)),
+	}
+	for _, item := range stats.Chains {
+		chainHeight, err := keeper.GetLastChainHeight(ctx, item.Chain)
+		if err != nil {
+			ctx.Logger().Error("fail to get last chain height", "error", err)
+			return nil, sdk.ErrInternal("fail to get last chain height")
+		}
+		result.Chains = append(result.Chains, QueryObserverChain{
+			Chain:              item.Chain,
+			ChainHeight:        chainHeight,
+			LastObservedHeight: item.LastObservedHeight,
+			Observations:       item.Observations,
+			MissedConsensus:    item.MissedConsensus,
+			AverageDelay:       item.AverageDelay(),
+		})
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), result)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal observer stats to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal observer stats to json")
+	}
+
+	return res, nil
+}
+
 // queryStakers
 func queryStakers(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	asset, err := common.NewAsset(path[0])
@@ -368,14 +613,32 @@ func queryStakers(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 		ctx.Logger().Error("fail to get parse asset", "error", err)
 		return nil, sdk.ErrInternal("fail to parse asset")
 	}
+	values, qErr := getQueryValues(req)
+	if qErr != nil {
+		return nil, qErr
+	}
+	pagination, qErr := getPaginationFromQuery(values)
+	if qErr != nil {
+		return nil, qErr
+	}
+	// only return the stakers with a rune or asset address starting with the given prefix
+	prefix := values.Get("address")
+
 	var stakers []Staker
 	iterator := keeper.GetStakerIterator(ctx, asset)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		var staker Staker
 		keeper.Cdc().MustUnmarshalBinaryBare(iterator.Value(), &staker)
+		if prefix != "" &&
+			!strings.HasPrefix(staker.RuneAddress.String(), prefix) &&
+			!strings.HasPrefix(staker.AssetAddress.String(), prefix) {
+			continue
+		}
 		stakers = append(stakers, staker)
 	}
+	start, end := pagination.Page(len(stakers))
+	stakers = stakers[start:end]
 	res, err := codec.MarshalJSONIndent(keeper.Cdc(), stakers)
 	if err != nil {
 		ctx.Logger().Error("fail to marshal stakers to json", "error", err)
@@ -426,8 +689,22 @@ func queryPool(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 }
 
 func queryPools(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	values, qErr := getQueryValues(req)
+	if qErr != nil {
+		return nil, qErr
+	}
+	pagination, qErr := getPaginationFromQuery(values)
+	if qErr != nil {
+		return nil, qErr
+	}
+	status := values.Get("status")
+	if status != "" && !strings.EqualFold(GetPoolStatus(status).String(), status) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid pool status: %s", status))
+	}
+
 	pools := QueryResPools{}
 	iterator := keeper.GetPoolIterator(ctx)
+	defer iterator.Close()
 
 	active, err := keeper.GetAsgardVaultsByStatus(ctx, ActiveVault)
 	if err != nil {
@@ -445,7 +722,15 @@ func queryPools(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 		if pool.PoolUnits.IsZero() {
 			continue
 		}
+		if status != "" && pool.Status != GetPoolStatus(status) {
+			continue
+		}
+		pools = append(pools, pool)
+	}
+	start, end := pagination.Page(len(pools))
+	pools = pools[start:end]
 
+	for i, pool := range pools {
 		vault := active.SelectByMinCoin(pool.Asset)
 		if vault.IsEmpty() {
 			return nil, sdk.ErrInternal("Could not find active asgard vault")
@@ -454,9 +739,7 @@ func queryPools(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 		if err != nil {
 			return nil, sdk.ErrInternal("Could get address of chain")
 		}
-
-		pool.PoolAddress = addr
-		pools = append(pools, pool)
+		pools[i].PoolAddress = addr
 	}
 	res, err := codec.MarshalJSONIndent(keeper.Cdc(), pools)
 	if err != nil {
@@ -681,10 +964,41 @@ func queryCompEvents(ctx sdk.Context, path []string, req abci.RequestQuery, keep
 	}
 	all := isIncludeAllEvents(u)
 	es := getEventStatusFromQuery(u)
+	values := url.Values{}
+	if u != nil {
+		values = u.Query()
+	}
+	// the id is the cursor, at most limit events are scanned from there.
+	// Without limit, events up to id+100 included are scanned, as they always were.
+	// The filters below apply within that window, so a page can hold fewer
+	// events than limit, even none, and the next page always starts at
+	// id+limit (id+101 without limit), whatever was filtered out
 	limit := int64(100) // limit the number of events, aka pagination
+	last := id + limit
+	if value := values.Get("limit"); value != "" {
+		l, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || l <= 0 {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid limit: %s", value))
+		}
+		if l < limit {
+			limit = l
+		}
+		last = id + limit - 1
+	}
+	eventType := values.Get("type")
+	fromHeight, toHeight, qErr := getHeightRangeFromQuery(values)
+	if qErr != nil {
+		return nil, qErr
+	}
 	events := make(Events, 0)
-	for i := id; i <= id+limit; i++ {
+	for i := id; i <= last; i++ {
 		event, _ := keeper.GetEvent(ctx, i)
+		if eventType != "" && !strings.EqualFold(event.Type, eventType) {
+			continue
+		}
+		if (fromHeight > 0 && event.Height < fromHeight) || (toHeight > 0 && event.Height > toHeight) {
+			continue
+		}
 		if all {
 			events = append(events, event)
 			continue
@@ -705,7 +1019,7 @@ func queryCompEvents(ctx sdk.Context, path []string, req abci.RequestQuery, keep
 		}
 		// if event is pending, get the chain event from memo
 		if event.Status == EventPending {
-			memo, _ := ParseMemo(event.InTx.Memo)
+			memo, _ := ParseMemoWithTHORNames(ctx, keeper, event.InTx.Memo)
 			asset := memo.GetAsset()
 			if asset.Chain != "" {
 				evtChain = asset.Chain
@@ -804,23 +1118,38 @@ func queryTSSSigners(ctx sdk.Context, path []string, req abci.RequestQuery, keep
 		ctx.Logger().Error("fail to get vault", "error", err)
 		return nil, sdk.ErrInternal("fail to get vault")
 	}
-	signers := vault.Membership
 	threshold, err := GetThreshold(len(vault.Membership))
 	if err != nil {
 		ctx.Logger().Error("fail to get threshold", "error", err)
 		return nil, sdk.ErrInternal("fail to get threshold")
 	}
+	// jailed node accounts are left out of the keysign party, unless there are not enough members without them
+	members, err := getUnjailedMembers(ctx, keeper, vault.Membership)
+	if err != nil {
+		ctx.Logger().Error("fail to get unjailed members", "error", err)
+		return nil, sdk.ErrInternal("fail to get unjailed members")
+	}
+	if len(members) < threshold {
+		members = vault.Membership
+	}
+	signers := members
 	totalObservingAccounts := len(accountAddrs)
 	if totalObservingAccounts > 0 && totalObservingAccounts >= threshold {
-		signers, err = vault.GetMembers(accountAddrs)
+		observing, err := vault.GetMembers(accountAddrs)
 		if err != nil {
 			ctx.Logger().Error("fail to get signers", "error", err)
 			return nil, sdk.ErrInternal("fail to get signers")
 		}
+		signers = common.PubKeys{}
+		for _, pk := range observing {
+			if members.Contains(pk) {
+				signers = append(signers, pk)
+			}
+		}
 	}
 	// if we don't have enough signer
 	if len(signers) < threshold {
-		signers = vault.Membership
+		signers = members
 	}
 	// if there are 9 nodes in total , it need 6 nodes to sign a message
 	// 3 signer send request to thorchain at block height 100
@@ -840,6 +1169,22 @@ func queryTSSSigners(ctx sdk.Context, path []string, req abci.RequestQuery, keep
 	return res, nil
 }
 
+// getUnjailedMembers filter out the members that belong to a jailed node account
+func getUnjailedMembers(ctx sdk.Context, keeper Keeper, members common.PubKeys) (common.PubKeys, error) {
+	unjailed := make(common.PubKeys, 0, len(members))
+	for _, pk := range members {
+		na, err := keeper.GetNodeAccountByPubKey(ctx, pk)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get node account by pub key(%s): %w", pk, err)
+		}
+		if na.IsJailed(ctx.BlockHeight()) {
+			continue
+		}
+		unjailed = append(unjailed, pk)
+	}
+	return unjailed, nil
+}
+
 func queryConstantValues(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	ver := keeper.GetLowestActiveVersion(ctx)
 	constAccessor := constants.GetConstantValues(ver)
@@ -891,3 +1236,159 @@ func queryBan(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keep
 	}
 	return res, nil
 }
+
+func querySlashes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("node address not provided")
+	}
+	addr, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		ctx.Logger().Error("invalid node address", "error", err)
+		return nil, sdk.ErrUnknownRequest("invalid node address")
+	}
+
+	slashPts, err := keeper.GetNodeAccountSlashPoints(ctx, addr)
+	if err != nil {
+		ctx.Logger().Error("fail to get node slash points", "error", err)
+		return nil, sdk.ErrInternal("fail to get node slash points")
+	}
+	records, err := keeper.GetNodeAccountSlashRecords(ctx, addr)
+	if err != nil {
+		ctx.Logger().Error("fail to get node slash records", "error", err)
+		return nil, sdk.ErrInternal("fail to get node slash records")
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), QueryResSlashes{
+		NodeAddress: addr,
+		SlashPoints: slashPts,
+		Records:     records,
+	})
+	if err != nil {
+		ctx.Logger().Error("fail to marshal slash records to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal slash records to json")
+	}
+	return res, nil
+}
+
+func queryNetworkFee(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("chain not provided")
+	}
+	chain, err := common.NewChain(path[0])
+	if err != nil {
+		ctx.Logger().Error("invalid chain", "error", err)
+		return nil, sdk.ErrUnknownRequest("invalid chain")
+	}
+	networkFee, err := keeper.GetNetworkFee(ctx, chain)
+	if err != nil {
+		ctx.Logger().Error("fail to get network fee", "error", err)
+		return nil, sdk.ErrInternal("fail to get network fee")
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), networkFee)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal network fee to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal network fee to json")
+	}
+	return res, nil
+}
+
+func queryMemoRef(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("memo reference id not provided")
+	}
+	id, err := strconv.ParseInt(path[0], 10, 64)
+	if err != nil {
+		ctx.Logger().Error("invalid memo reference id", "error", err)
+		return nil, sdk.ErrUnknownRequest("invalid memo reference id")
+	}
+	ref, err := keeper.GetMemoRef(ctx, id)
+	if err != nil {
+		ctx.Logger().Error("fail to get memo ref", "error", err)
+		return nil, sdk.ErrInternal("fail to get memo ref")
+	}
+	if ref.IsEmpty() {
+		return nil, sdk.ErrUnknownRequest("memo reference doesn't exist")
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), ref)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal memo ref to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal memo ref to json")
+	}
+	return res, nil
+}
+
+func queryTHORName(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("thorname not provided")
+	}
+	tn, err := keeper.GetTHORName(ctx, path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to get thorname", "error", err)
+		return nil, sdk.ErrInternal("fail to get thorname")
+	}
+	if tn.IsEmpty() || tn.IsExpired(ctx.BlockHeight()) {
+		return nil, sdk.ErrUnknownRequest("thorname doesn't exist")
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), tn)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal thorname to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal thorname to json")
+	}
+	return res, nil
+}
+
+func queryPendingLiquidity(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	var pendings []PendingLiquidity
+	iterator := keeper.GetPendingLiquidityIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pending PendingLiquidity
+		if err := keeper.Cdc().UnmarshalBinaryBare(iterator.Value(), &pending); err != nil {
+			ctx.Logger().Error("fail to unmarshal pending liquidity", "error", err)
+			return nil, sdk.ErrInternal("fail to unmarshal pending liquidity")
+		}
+		pendings = append(pendings, pending)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), pendings)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal pending liquidity to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal pending liquidity to json")
+	}
+	return res, nil
+}
+
+// queryOutboundQueue return the outbound txs that haven't been sent yet, an
+// outbound still pending after SigningTransactionPeriod blocks is scheduled
+// again at a later height, so only the last SigningTransactionPeriod blocks are
+// looked at
+func queryOutboundQueue(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	constAccessor := constants.GetConstantValues(keeper.GetLowestActiveVersion(ctx))
+	if constAccessor == nil {
+		// no active node accounts
+		constAccessor = constants.GetConstantValues(constants.SWVersion)
+	}
+	signingTransPeriod := constAccessor.GetInt64Value(constants.SigningTransactionPeriod)
+	startHeight := ctx.BlockHeight() - signingTransPeriod + 1
+	if startHeight < 1 {
+		startHeight = 1
+	}
+	items := make([]TxOutItem, 0)
+	for height := startHeight; height <= ctx.BlockHeight(); height++ {
+		txs, err := keeper.GetTxOut(ctx, height)
+		if err != nil {
+			ctx.Logger().Error("fail to get tx out array from key value store", "error", err)
+			return nil, sdk.ErrInternal("fail to get tx out array from key value store")
+		}
+		for _, item := range txs.TxArray {
+			if item.OutHash.IsEmpty() {
+				items = append(items, *item)
+			}
+		}
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), items)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal outbound queue to json", "error", err)
+		return nil, sdk.ErrInternal("fail to marshal outbound queue to json")
+	}
+	return res, nil
+}
@@ -2,6 +2,7 @@ package thorchain
 
 import (
 	"encoding/json"
+	"net/url"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -24,6 +25,15 @@ func (k *TestQuerierKeeper) GetTxOut(_ sdk.Context, _ int64) (*TxOut, error) {
 	return k.txOut, nil
 }
 
+// requestWithQueryString returns a query request the way the rest api sends
+// it, with the given query string params
+func requestWithQueryString(c *C, rawQuery string) abci.RequestQuery {
+	u := url.URL{Path: "/thorchain", RawQuery: rawQuery}
+	data, err := u.MarshalBinary()
+	c.Assert(err, IsNil)
+	return abci.RequestQuery{Data: data}
+}
+
 func (s *QuerierSuite) TestQueryKeysign(c *C) {
 	ctx, _ := setupKeeperForTest(c)
 	ctx = ctx.WithBlockHeight(12)
@@ -109,6 +119,71 @@ func (s *QuerierSuite) TestQueryPool(c *C) {
 	err = keeper.Cdc().UnmarshalJSON(res, &out)
 	c.Assert(err, IsNil)
 	c.Assert(len(out), Equals, 2)
+
+	poolBTC.Status = PoolBootstrap
+	c.Assert(keeper.SetPool(ctx, poolBTC), IsNil)
+	res, err = querier(ctx, path, requestWithQueryString(c, "status=bootstrap"))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 1)
+	c.Check(out[0].Asset.Equals(common.BTCAsset), Equals, true)
+	c.Check(out[0].PoolAddress.IsEmpty(), Equals, false)
+
+	res, err = querier(ctx, path, requestWithQueryString(c, "limit=1&offset=1"))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 1)
+
+	res, err = querier(ctx, path, requestWithQueryString(c, "offset=5"))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 0)
+
+	_, err = querier(ctx, path, requestWithQueryString(c, "status=whatever"))
+	c.Assert(err, NotNil)
+	_, err = querier(ctx, path, requestWithQueryString(c, "limit=-1"))
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryStakers(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	querier := NewQuerier(keeper, nil)
+	path := []string{"stakers", common.BNBAsset.String()}
+
+	for i := 0; i < 3; i++ {
+		keeper.SetStaker(ctx, Staker{
+			Asset:        common.BNBAsset,
+			RuneAddress:  GetRandomBNBAddress(),
+			AssetAddress: GetRandomBNBAddress(),
+			Units:        sdk.NewUint(100),
+			PendingRune:  sdk.ZeroUint(),
+		})
+	}
+	staker := Staker{
+		Asset:        common.BNBAsset,
+		RuneAddress:  common.Address("bnb1xlvns0n2mxh77mzaspn2hgav4rr4m8eerfju38"),
+		AssetAddress: common.Address("bnb1xlvns0n2mxh77mzaspn2hgav4rr4m8eerfju38"),
+		Units:        sdk.NewUint(100),
+		PendingRune:  sdk.ZeroUint(),
+	}
+	keeper.SetStaker(ctx, staker)
+
+	var out []Staker
+	res, err := querier(ctx, path, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out, HasLen, 4)
+
+	res, err = querier(ctx, path, requestWithQueryString(c, "limit=3&offset=2"))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out, HasLen, 2)
+
+	res, err = querier(ctx, path, requestWithQueryString(c, "address=bnb1xlvns0n2"))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 1)
+	c.Check(out[0].RuneAddress.Equals(staker.RuneAddress), Equals, true)
 }
 
 func (s *QuerierSuite) TestQueryNodeAccounts(c *C) {
@@ -161,6 +236,22 @@ func (s *QuerierSuite) TestQueryNodeAccounts(c *C) {
 	err1 = keeper.Cdc().UnmarshalJSON(res, &out)
 	c.Assert(err1, IsNil)
 	c.Assert(len(out), Equals, 1)
+
+	nodeAccount3 := GetRandomNodeAccount(NodeStandby)
+	c.Assert(keeper.SetNodeAccount(ctx, nodeAccount3), IsNil)
+	res, err = querier(ctx, path, requestWithQueryString(c, "status=standby"))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 1)
+	c.Check(out[0].NodeAddress.Equals(nodeAccount3.NodeAddress), Equals, true)
+
+	res, err = querier(ctx, path, requestWithQueryString(c, "limit=1"))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out, HasLen, 1)
+
+	_, err = querier(ctx, path, requestWithQueryString(c, "status=whatever"))
+	c.Assert(err, NotNil)
 }
 
 func (s *QuerierSuite) TestQueryCompEvents(c *C) {
@@ -298,4 +389,331 @@ func (s *QuerierSuite) TestQueryCompEvents(c *C) {
 	c.Assert(len(out), Equals, 4)
 	c.Assert(out[2].OutTxs[0].Chain.Equals(common.BTCChain), Equals, true)
 	c.Assert(out[3].InTx.Chain.IsEmpty(), Equals, true)
+
+	// filter on event type and height range, and limit the events scanned
+	evt.Type = "gas"
+	evt.Height = 20
+	c.Assert(keeper.UpsertEvent(ctx, evt), IsNil)
+	path = []string{"comp_events", "1"}
+	res, err = querier(ctx, path, requestWithQueryString(c, "type=gas"))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 1)
+	c.Check(out[0].Type, Equals, "gas")
+
+	res, err = querier(ctx, path, requestWithQueryString(c, "from_height=13&to_height=30"))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 1)
+	c.Check(out[0].Height, Equals, int64(20))
+
+	res, err = querier(ctx, path, requestWithQueryString(c, "to_height=12"))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out, HasLen, 4)
+
+	res, err = querier(ctx, path, requestWithQueryString(c, "limit=1"))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out, HasLen, 1)
+
+	// without limit, the event at id+100 is included
+	evt.ID = 101
+	evt.Type = "refund"
+	c.Assert(keeper.UpsertEvent(ctx, evt), IsNil)
+	res, err = querier(ctx, path, requestWithQueryString(c, "type=refund"))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 1)
+	c.Check(out[0].ID, Equals, int64(101))
+	res, err = querier(ctx, path, requestWithQueryString(c, "type=refund&limit=100"))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out, HasLen, 0)
+
+	_, err = querier(ctx, path, requestWithQueryString(c, "from_height=abc"))
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQuerySlashes(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	querier := NewQuerier(keeper, nil)
+
+	_, err := querier(ctx, []string{"slashes", "bogus"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+
+	addr := GetRandomBech32Addr()
+	c.Assert(incSlashPoints(ctx, keeper, addr, 2, SlashReasonLackOfObservation), IsNil)
+	c.Assert(addSlashRecord(ctx, keeper, addr, SlashReasonExcessOutbound, 0, sdk.NewUint(100)), IsNil)
+	res, err := querier(ctx, []string{"slashes", addr.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+
+	var out QueryResSlashes
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out.NodeAddress.Equals(addr), Equals, true)
+	c.Check(out.SlashPoints, Equals, int64(2))
+	c.Assert(out.Records, HasLen, 2)
+	c.Check(out.Records[0].Reason, Equals, SlashReasonLackOfObservation)
+	c.Check(out.Records[1].Bond.Equal(sdk.NewUint(100)), Equals, true)
+}
+
+func (s *QuerierSuite) TestQueryNetworkFee(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	querier := NewQuerier(keeper, nil)
+
+	_, err := querier(ctx, []string{"networkfee"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+
+	c.Assert(keeper.SaveNetworkFee(ctx, common.BTCChain, NewNetworkFee(common.BTCChain, 250, 10)), IsNil)
+	res, err := querier(ctx, []string{"networkfee", "BTC"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+
+	var out NetworkFee
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out.Chain.Equals(common.BTCChain), Equals, true)
+	c.Check(out.TransactionSize, Equals, uint64(250))
+	c.Check(out.TransactionFeeRate, Equals, uint64(10))
+}
+
+func (s *QuerierSuite) TestQueryMemoRef(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	querier := NewQuerier(keeper, nil)
+
+	_, err := querier(ctx, []string{"memoref", "bogus"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+	_, err = querier(ctx, []string{"memoref", "1"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+
+	c.Assert(keeper.SetMemoRef(ctx, NewMemoRef(1, "SWAP:BNB.BNB", GetRandomBech32Addr(), 10)), IsNil)
+	res, err := querier(ctx, []string{"memoref", "1"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+
+	var out MemoRef
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out.ID, Equals, int64(1))
+	c.Check(out.Memo, Equals, "SWAP:BNB.BNB")
+}
+
+func (s *QuerierSuite) TestQueryTHORName(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	querier := NewQuerier(keeper, nil)
+
+	_, err := querier(ctx, []string{"thorname"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+	_, err = querier(ctx, []string{"thorname", "bob"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+
+	bnbAddr := GetRandomBNBAddress()
+	tn := NewTHORName("bob", 100, GetRandomBech32Addr())
+	tn.SetAlias(common.BNBChain, bnbAddr)
+	c.Assert(keeper.SetTHORName(ctx, tn), IsNil)
+	res, err := querier(ctx, []string{"thorname", "bob"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+
+	var out THORName
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out.Name, Equals, "bob")
+	c.Check(out.GetAlias(common.BNBChain).Equals(bnbAddr), Equals, true)
+
+	// expired
+	_, err = querier(ctx.WithBlockHeight(100), []string{"thorname", "bob"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryPendingLiquidity(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	querier := NewQuerier(keeper, nil)
+
+	pending := NewPendingLiquidity(common.BTCAsset, GetRandomBNBAddress(), GetRandomBTCAddress())
+	pending.Add(sdk.NewUint(common.One), sdk.ZeroUint(), GetRandomTx())
+	pending.ExpireBlockHeight = 100
+	c.Assert(keeper.SetPendingLiquidity(ctx, pending), IsNil)
+	res, err := querier(ctx, []string{"pendingliquidity"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+
+	var out []PendingLiquidity
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 1)
+	c.Check(out[0].RuneAddress.Equals(pending.RuneAddress), Equals, true)
+	c.Check(out[0].AssetAddress.Equals(pending.AssetAddress), Equals, true)
+	c.Check(out[0].PendingRune.Equal(sdk.NewUint(common.One)), Equals, true)
+	c.Check(out[0].ExpireBlockHeight, Equals, int64(100))
+}
+
+func (s *QuerierSuite) TestQueryOutboundQueue(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(1000)
+	querier := NewQuerier(keeper, nil)
+
+	newItem := func() *TxOutItem {
+		return &TxOutItem{
+			Chain:       common.BNBChain,
+			ToAddress:   GetRandomBNBAddress(),
+			VaultPubKey: GetRandomPubKey(),
+			Coin:        common.NewCoin(common.BNBAsset, sdk.NewUint(common.One)),
+			InHash:      GetRandomTxHash(),
+		}
+	}
+	pending := newItem()
+	c.Assert(keeper.AppendTxOut(ctx, 900, pending), IsNil)
+	sent := newItem()
+	sent.OutHash = GetRandomTxHash()
+	c.Assert(keeper.AppendTxOut(ctx, 900, sent), IsNil)
+	// scheduled again at a later height
+	c.Assert(keeper.AppendTxOut(ctx, 500, newItem()), IsNil)
+
+	res, err := querier(ctx, []string{"outboundqueue"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var out []TxOutItem
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 1)
+	c.Check(out[0].Equals(*pending), Equals, true)
+}
+
+func (s *QuerierSuite) TestQueryVaultMigrations(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(500)
+	querier := NewQuerier(keeper, nil)
+
+	retiring := GetRandomVault()
+	retiring.Status = RetiringVault
+	retiring.StatusSince = 100
+	retiring.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(100*common.One)),
+		common.NewCoin(common.RuneAsset(), sdk.NewUint(1000*common.One)),
+	}
+	retiring.PendingTxBlockHeights = []int64{460}
+	c.Assert(keeper.SetVault(ctx, retiring), IsNil)
+	plan := NewMigrationPlan(100, retiring)
+	plan.AddTxIssued(retiring.Coins[0])
+	c.Assert(keeper.SetMigrationPlan(ctx, plan), IsNil)
+	c.Assert(keeper.SetVault(ctx, GetRandomVault()), IsNil)
+
+	res, err := querier(ctx, []string{"vaultmigrations"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var out []QueryResMigration
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 1)
+	c.Check(out[0].Plan.VaultPubKey.Equals(retiring.PubKey), Equals, true)
+	c.Check(out[0].Plan.GetItem(common.BNBAsset).TxIssued, Equals, int64(1))
+	c.Check(out[0].Remaining, HasLen, 2)
+	c.Check(out[0].PendingTxs, Equals, 1)
+	c.Check(out[0].NextRoundHeight, Equals, int64(820))
+}
+
+func (s *QuerierSuite) TestQueryTSSSignersExcludeJailed(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(100)
+	querier := NewQuerier(keeper, nil)
+
+	nas := NodeAccounts{}
+	members := common.PubKeys{}
+	for i := 0; i < 5; i++ {
+		na := GetRandomNodeAccount(NodeActive)
+		if i == 0 {
+			na.Jail(ctx.BlockHeight() + 10)
+		}
+		c.Assert(keeper.SetNodeAccount(ctx, na), IsNil)
+		nas = append(nas, na)
+		members = append(members, na.PubKeySet.Secp256k1)
+	}
+	vault := GetRandomVault()
+	vault.Membership = members
+	c.Assert(keeper.SetVault(ctx, vault), IsNil)
+
+	res, err := querier(ctx, []string{"tsssigner", vault.PubKey.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var signers common.PubKeys
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &signers), IsNil)
+	c.Assert(len(signers) > 0, Equals, true)
+	c.Check(signers.Contains(nas[0].PubKeySet.Secp256k1), Equals, false)
+}
+
+func (s *QuerierSuite) TestQueryPoolAddresses(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	querier := NewQuerier(keeper, nil)
+
+	asgard1 := GetRandomVault()
+	asgard1.Coins = common.Coins{common.NewCoin(common.RuneAsset(), sdk.NewUint(200*common.One))}
+	c.Assert(keeper.SetVault(ctx, asgard1), IsNil)
+	asgard2 := GetRandomVault()
+	asgard2.Coins = common.Coins{common.NewCoin(common.RuneAsset(), sdk.NewUint(100*common.One))}
+	c.Assert(keeper.SetVault(ctx, asgard2), IsNil)
+
+	res, err := querier(ctx, []string{"pooladdresses"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var out struct {
+		Current []struct {
+			PubKey common.PubKey `json:"pub_key"`
+		} `json:"current"`
+	}
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out.Current, HasLen, 2)
+	c.Check(out.Current[0].PubKey.Equals(asgard2.PubKey), Equals, true)
+	c.Check(out.Current[1].PubKey.Equals(asgard1.PubKey), Equals, true)
+}
+
+func (s *QuerierSuite) TestQueryObserver(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	querier := NewQuerier(keeper, nil)
+
+	na := GetRandomNodeAccount(NodeActive)
+	c.Assert(keeper.SetNodeAccount(ctx, na), IsNil)
+	c.Assert(keeper.SetLastChainHeight(ctx, common.BNBChain, 105), IsNil)
+	stats := NewObserverStats(na.NodeAddress)
+	stats.AddObservation(common.BNBChain, 100, 4, true)
+	stats.AddObservation(common.BNBChain, 101, 2, false)
+	keeper.SetObserverStats(ctx, stats)
+
+	// the observer route still return the node account
+	res, err := querier(ctx, []string{"observer", na.NodeAddress.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var nodeAcc NodeAccount
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &nodeAcc), IsNil)
+	c.Check(nodeAcc.NodeAddress.Equals(na.NodeAddress), Equals, true)
+
+	res, err = querier(ctx, []string{"observerstats", na.NodeAddress.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var out QueryResObserverStats
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out.NodeAddress.Equals(na.NodeAddress), Equals, true)
+	c.Assert(out.Chains, HasLen, 1)
+	c.Check(out.Chains[0].ChainHeight, Equals, int64(105))
+	c.Check(out.Chains[0].LastObservedHeight, Equals, int64(101))
+	c.Check(out.Chains[0].Observations, Equals, int64(2))
+	c.Check(out.Chains[0].MissedConsensus, Equals, int64(1))
+	c.Check(out.Chains[0].AverageDelay, Equals, int64(3))
+}
+
+func (s *QuerierSuite) TestQueryYggdrasilVaults(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	querier := NewQuerier(keeper, nil)
+	path := []string{"vaultsyggdrasil"}
+
+	for _, status := range []NodeStatus{NodeActive, NodeActive, NodeStandby} {
+		na := GetRandomNodeAccount(status)
+		c.Assert(keeper.SetNodeAccount(ctx, na), IsNil)
+		ygg := NewVault(ctx.BlockHeight(), ActiveVault, YggdrasilVault, na.PubKeySet.Secp256k1, common.Chains{common.BNBChain})
+		ygg.AddFunds(common.Coins{common.NewCoin(common.RuneAsset(), sdk.NewUint(common.One))})
+		c.Assert(keeper.SetVault(ctx, ygg), IsNil)
+	}
+
+	var out []QueryYggdrasilVaults
+	res, err := querier(ctx, path, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out, HasLen, 3)
+
+	res, err = querier(ctx, path, requestWithQueryString(c, "status=active&limit=1"))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Assert(out, HasLen, 1)
+	c.Check(out[0].Status, Equals, NodeActive)
+	c.Check(out[0].TotalValue.Equal(sdk.NewUint(common.One)), Equals, true)
+
+	res, err = querier(ctx, path, requestWithQueryString(c, "status=standby"))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.Cdc().UnmarshalJSON(res, &out), IsNil)
+	c.Check(out, HasLen, 1)
 }
@@ -7,8 +7,13 @@ import (
 
 // Query define all the queries
 type Query struct {
-	Key              string
+	Key string
+	// Use names the cli query command, the key is kept as an alias of it
+	Use              string
 	EndpointTemplate string
+	// Args name the parameters of the query, in the order they appear in the
+	// endpoint, they are used as the arguments of the cli query command
+	Args []string
 }
 
 // Endpoint return the end point string
@@ -33,31 +38,39 @@ func (q Query) Path(args ...string) string {
 
 // query endpoints supported by the thorchain Querier
 var (
-	QueryPool               = Query{Key: "pool", EndpointTemplate: "/%s/pool/{%s}"}
-	QueryPools              = Query{Key: "pools", EndpointTemplate: "/%s/pools"}
-	QueryStakers            = Query{Key: "stakers", EndpointTemplate: "/%s/pool/{%s}/stakers"}
-	QueryTxIn               = Query{Key: "txin", EndpointTemplate: "/%s/tx/{%s}"}
-	QueryKeysignArray       = Query{Key: "keysign", EndpointTemplate: "/%s/keysign/{%s}"}
-	QueryKeysignArrayPubkey = Query{Key: "keysignpubkey", EndpointTemplate: "/%s/keysign/{%s}/{%s}"}
-	QueryKeygensPubkey      = Query{Key: "keygenspubkey", EndpointTemplate: "/%s/keygen/{%s}/{%s}"}
-	QueryCompEvents         = Query{Key: "comp_events", EndpointTemplate: "/%s/events/{%s}"}
-	QueryCompEventsByChain  = Query{Key: "comp_events_chain", EndpointTemplate: "/%s/events/{%s}/{%s}"}
-	QueryEventsByTxHash     = Query{Key: "txhash_events", EndpointTemplate: "/%s/events/tx/{%s}"}
-	QueryHeights            = Query{Key: "heights", EndpointTemplate: "/%s/lastblock"}
-	QueryChainHeights       = Query{Key: "chainheights", EndpointTemplate: "/%s/lastblock/{%s}"}
-	QueryObservers          = Query{Key: "observers", EndpointTemplate: "/%s/observers"}
-	QueryObserver           = Query{Key: "observer", EndpointTemplate: "/%s/observer/{%s}"}
-	QueryNodeAccounts       = Query{Key: "nodeaccounts", EndpointTemplate: "/%s/nodeaccounts"}
-	QueryNodeAccount        = Query{Key: "nodeaccount", EndpointTemplate: "/%s/nodeaccount/{%s}"}
-	QueryPoolAddresses      = Query{Key: "pooladdresses", EndpointTemplate: "/%s/pool_addresses"}
-	QueryVaultData          = Query{Key: "vaultdata", EndpointTemplate: "/%s/vault"}
-	QueryVaultsAsgard       = Query{Key: "vaultsasgard", EndpointTemplate: "/%s/vaults/asgard"}
-	QueryVaultsYggdrasil    = Query{Key: "vaultsyggdrasil", EndpointTemplate: "/%s/vaults/yggdrasil"}
-	QueryVaultPubkeys       = Query{Key: "vaultpubkeys", EndpointTemplate: "/%s/vaults/pubkeys"}
-	QueryTSSSigners         = Query{Key: "tsssigner", EndpointTemplate: "/%s/vaults/{%s}/signers"}
-	QueryConstantValues     = Query{Key: "constants", EndpointTemplate: "/%s/constants"}
-	QueryMimirValues        = Query{Key: "mimirs", EndpointTemplate: "/%s/mimir"}
-	QueryBan                = Query{Key: "ban", EndpointTemplate: "/%s/ban/{%s}"}
+	QueryPool               = Query{Key: "pool", Use: "pool", EndpointTemplate: "/%s/pool/{%s}", Args: []string{"asset"}}
+	QueryPools              = Query{Key: "pools", Use: "pools", EndpointTemplate: "/%s/pools"}
+	QueryStakers            = Query{Key: "stakers", Use: "stakers", EndpointTemplate: "/%s/pool/{%s}/stakers", Args: []string{"asset"}}
+	QueryTxIn               = Query{Key: "txin", Use: "tx", EndpointTemplate: "/%s/tx/{%s}", Args: []string{"txid"}}
+	QueryKeysignArray       = Query{Key: "keysign", Use: "keysign", EndpointTemplate: "/%s/keysign/{%s}", Args: []string{"height"}}
+	QueryKeysignArrayPubkey = Query{Key: "keysignpubkey", Use: "keysign-pubkey", EndpointTemplate: "/%s/keysign/{%s}/{%s}", Args: []string{"height", "pubkey"}}
+	QueryKeygensPubkey      = Query{Key: "keygenspubkey", Use: "keygen", EndpointTemplate: "/%s/keygen/{%s}/{%s}", Args: []string{"height", "pubkey"}}
+	QueryCompEvents         = Query{Key: "comp_events", Use: "events", EndpointTemplate: "/%s/events/{%s}", Args: []string{"id"}}
+	QueryCompEventsByChain  = Query{Key: "comp_events_chain", Use: "events-chain", EndpointTemplate: "/%s/events/{%s}/{%s}", Args: []string{"id", "chain"}}
+	QueryEventsByTxHash     = Query{Key: "txhash_events", Use: "events-tx", EndpointTemplate: "/%s/events/tx/{%s}", Args: []string{"txid"}}
+	QueryHeights            = Query{Key: "heights", Use: "last-block", EndpointTemplate: "/%s/lastblock"}
+	QueryChainHeights       = Query{Key: "chainheights", Use: "last-block-chain", EndpointTemplate: "/%s/lastblock/{%s}", Args: []string{"chain"}}
+	QueryObservers          = Query{Key: "observers", Use: "observers", EndpointTemplate: "/%s/observers"}
+	QueryObserver           = Query{Key: "observer", Use: "observer", EndpointTemplate: "/%s/observer/{%s}", Args: []string{"address"}}
+	QueryObserverStats      = Query{Key: "observerstats", Use: "observer-stats", EndpointTemplate: "/%s/observer/{%s}/stats", Args: []string{"address"}}
+	QueryNodeAccounts       = Query{Key: "nodeaccounts", Use: "node-accounts", EndpointTemplate: "/%s/nodeaccounts"}
+	QueryNodeAccount        = Query{Key: "nodeaccount", Use: "node-account", EndpointTemplate: "/%s/nodeaccount/{%s}", Args: []string{"address"}}
+	QueryPoolAddresses      = Query{Key: "pooladdresses", Use: "pool-addresses", EndpointTemplate: "/%s/pool_addresses"}
+	QueryVaultData          = Query{Key: "vaultdata", Use: "vault", EndpointTemplate: "/%s/vault"}
+	QueryVaultsAsgard       = Query{Key: "vaultsasgard", Use: "asgard-vaults", EndpointTemplate: "/%s/vaults/asgard"}
+	QueryVaultsYggdrasil    = Query{Key: "vaultsyggdrasil", Use: "yggdrasil-vaults", EndpointTemplate: "/%s/vaults/yggdrasil"}
+	QueryVaultPubkeys       = Query{Key: "vaultpubkeys", Use: "vault-pubkeys", EndpointTemplate: "/%s/vaults/pubkeys"}
+	QueryVaultMigrations    = Query{Key: "vaultmigrations", Use: "vault-migrations", EndpointTemplate: "/%s/vaults/migrations"}
+	QueryTSSSigners         = Query{Key: "tsssigner", Use: "tss-signers", EndpointTemplate: "/%s/vaults/{%s}/signers", Args: []string{"pubkey"}}
+	QueryConstantValues     = Query{Key: "constants", Use: "constants", EndpointTemplate: "/%s/constants"}
+	QueryMimirValues        = Query{Key: "mimirs", Use: "mimir", EndpointTemplate: "/%s/mimir"}
+	QueryBan                = Query{Key: "ban", Use: "ban", EndpointTemplate: "/%s/ban/{%s}", Args: []string{"address"}}
+	QuerySlashes            = Query{Key: "slashes", Use: "slashes", EndpointTemplate: "/%s/slashes/{%s}", Args: []string{"address"}}
+	QueryNetworkFee         = Query{Key: "networkfee", Use: "network-fee", EndpointTemplate: "/%s/network_fee/{%s}", Args: []string{"chain"}}
+	QueryMemoRef            = Query{Key: "memoref", Use: "memo", EndpointTemplate: "/%s/memo/{%s}", Args: []string{"id"}}
+	QueryTHORName           = Query{Key: "thorname", Use: "thorname", EndpointTemplate: "/%s/thorname/{%s}", Args: []string{"name"}}
+	QueryPendingLiquidity   = Query{Key: "pendingliquidity", Use: "pending-liquidity", EndpointTemplate: "/%s/pending_liquidity"}
+	QueryOutboundQueue      = Query{Key: "outboundqueue", Use: "outbound-queue", EndpointTemplate: "/%s/queue/outbound"}
 )
 
 // Queries all queries
@@ -75,6 +88,7 @@ var Queries = []Query{
 	QueryChainHeights,
 	QueryObservers,
 	QueryObserver,
+	QueryObserverStats,
 	QueryNodeAccount,
 	QueryNodeAccounts,
 	QueryPoolAddresses,
@@ -82,9 +96,16 @@ var Queries = []Query{
 	QueryVaultsAsgard,
 	QueryVaultsYggdrasil,
 	QueryVaultPubkeys,
+	QueryVaultMigrations,
 	QueryKeygensPubkey,
 	QueryTSSSigners,
 	QueryConstantValues,
 	QueryMimirValues,
 	QueryBan,
+	QuerySlashes,
+	QueryNetworkFee,
+	QueryMemoRef,
+	QueryTHORName,
+	QueryPendingLiquidity,
+	QueryOutboundQueue,
 }
@@ -1,6 +1,7 @@
 package query
 
 import (
+	"strings"
 	"testing"
 
 	. "gopkg.in/check.v1"
@@ -16,3 +17,21 @@ func (s QuerySuite) TestQuery(c *C) {
 	c.Check(QueryTxIn.Endpoint("foo", "bar"), Equals, "/foo/tx/{bar}")
 	c.Check(QueryTxIn.Path("foo", "bar"), Equals, "custom/foo/txin/bar")
 }
+
+func (s QuerySuite) TestQueryArgs(c *C) {
+	for _, q := range Queries {
+		// the first %s is the store name
+		count := strings.Count(q.EndpointTemplate, "%s") - 1
+		c.Check(q.Args, HasLen, count, Commentf("%s", q.Key))
+	}
+}
+
+func (s QuerySuite) TestQueryUse(c *C) {
+	seen := make(map[string]bool)
+	for _, q := range Queries {
+		c.Check(q.Use, Not(Equals), "", Commentf("%s", q.Key))
+		c.Check(seen[q.Use], Equals, false, Commentf("%s", q.Use))
+		c.Check(strings.ContainsAny(q.Use, " _"), Equals, false, Commentf("%s", q.Use))
+		seen[q.Use] = true
+	}
+}
@@ -32,14 +32,14 @@ func NewSlasher(keeper Keeper, version semver.Version, versionedEventManager Ver
 	return nil, errBadVersion
 }
 
-func (s *Slasher) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, constAccessor constants.ConstantValues) {
+func (s *Slasher) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, constAccessor constants.ConstantValues, validatorMgr VersionedValidatorManager) {
 	// Iterate through any newly discovered evidence of infraction
 	// Slash any validators (and since-unbonded stake within the unbonding period)
 	// who contributed to valid infractions
 	for _, evidence := range req.ByzantineValidators {
 		switch evidence.Type {
 		case tmtypes.ABCIEvidenceTypeDuplicateVote:
-			if err := s.HandleDoubleSign(ctx, evidence.Validator.Address, evidence.Height, constAccessor); err != nil {
+			if err := s.HandleDoubleSign(ctx, evidence.Validator.Address, evidence.Height, constAccessor, validatorMgr); err != nil {
 				ctx.Logger().Error("fail to slash for double signing a block", "error", err)
 			}
 		default:
@@ -49,9 +49,11 @@ func (s *Slasher) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, constA
 }
 
 // HandleDoubleSign - slashes a validator for singing two blocks at the same
-// block height
+// block height, the node account is forced to leave at the next churn, and
+// its yggdrasil funds are recalled right away. Its bond stay locked until the
+// yggdrasil funds are returned
 // https://blog.cosmos.network/consensus-compare-casper-vs-tendermint-6df154ad56ae
-func (s *Slasher) HandleDoubleSign(ctx sdk.Context, addr crypto.Address, infractionHeight int64, constAccessor constants.ConstantValues) error {
+func (s *Slasher) HandleDoubleSign(ctx sdk.Context, addr crypto.Address, infractionHeight int64, constAccessor constants.ConstantValues, validatorMgr VersionedValidatorManager) error {
 	// check if we're recent enough to slash for this behavior
 	maxAge := constAccessor.GetInt64Value(constants.DoubleSignMaxAge)
 	if (ctx.BlockHeight() - infractionHeight) > maxAge {
@@ -80,7 +82,10 @@ func (s *Slasher) HandleDoubleSign(ctx sdk.Context, addr crypto.Address, infract
 				minBond = constAccessor.GetInt64Value(constants.MinimumBondInRune)
 			}
 			slashAmount := sdk.NewUint(uint64(minBond)).MulUint64(5).QuoUint64(100)
-			na.Bond = common.SafeSub(na.Bond, slashAmount)
+			na.SubBond(slashAmount)
+			if err := addSlashRecord(ctx, s.keeper, na.NodeAddress, SlashReasonDoubleSign, 0, slashAmount); err != nil {
+				ctx.Logger().Error("fail to add slash record", "error", err)
+			}
 
 			if common.RuneAsset().Chain.Equals(common.THORChain) {
 				coin := common.NewCoin(common.RuneNative, slashAmount)
@@ -99,7 +104,34 @@ func (s *Slasher) HandleDoubleSign(ctx sdk.Context, addr crypto.Address, infract
 				}
 			}
 
-			return s.keeper.SetNodeAccount(ctx, na)
+			na.ForcedToLeave = true
+			na.LeaveHeight = ctx.BlockHeight()
+			if err := s.keeper.SetNodeAccount(ctx, na); err != nil {
+				return fmt.Errorf("fail to save node account: %w", err)
+			}
+
+			// the event records the rune moved from the node's bond to the reserve,
+			// no pool is involved, so the asset of the event is rune itself
+			eventSlash := NewEventSlash(common.RuneAsset(), []PoolAmt{
+				{
+					Asset:  common.RuneAsset(),
+					Amount: 0 - int64(slashAmount.Uint64()),
+				},
+			})
+			eventSlash.Reason = string(SlashReasonDoubleSign)
+			eventMgr, err := s.versionedEventManager.GetEventManager(ctx, s.version)
+			if err != nil {
+				return fmt.Errorf("fail to get event manager: %w", err)
+			}
+			if err := eventMgr.EmitSlashEvent(ctx, s.keeper, eventSlash); err != nil {
+				return fmt.Errorf("fail to emit slash event: %w", err)
+			}
+
+			// don't wait for the churn, get the funds in yggdrasil vault back now
+			if err := validatorMgr.RequestYggReturn(ctx, s.version, na); err != nil {
+				return fmt.Errorf("fail to request yggdrasil return fund: %w", err)
+			}
+			return nil
 		}
 	}
 
@@ -138,7 +170,7 @@ func (s *Slasher) LackObserving(ctx sdk.Context, constAccessor constants.Constan
 		// this na is not found, therefore it should be slashed
 		if !found {
 			lackOfObservationPenalty := constAccessor.GetInt64Value(constants.LackOfObservationPenalty)
-			if err := s.keeper.IncNodeAccountSlashPoints(ctx, na.NodeAddress, lackOfObservationPenalty); err != nil {
+			if err := incSlashPoints(ctx, s.keeper, na.NodeAddress, lackOfObservationPenalty, SlashReasonLackOfObservation); err != nil {
 				ctx.Logger().Error("fail to inc slash points", "error", err)
 			}
 		}
@@ -180,7 +212,7 @@ func (s *Slasher) LackSigning(ctx sdk.Context, constAccessor constants.ConstantV
 							ctx.Logger().Error("Unable to get node account", "error", err)
 							continue
 						}
-						if err := s.keeper.IncNodeAccountSlashPoints(ctx, na.NodeAddress, signingTransPeriod*2); err != nil {
+						if err := incSlashPoints(ctx, s.keeper, na.NodeAddress, signingTransPeriod*2, SlashReasonFailToSign); err != nil {
 							ctx.Logger().Error("fail to inc slash points", "error", err)
 						}
 					}
@@ -253,7 +285,10 @@ func (s *Slasher) SlashNodeAccount(ctx sdk.Context, observedPubKey common.PubKey
 		amountToReserve := slashAmount.QuoUint64(2)
 		// if the diff asset is RUNE , just took 1.5 * diff from their bond
 		slashAmount = slashAmount.MulUint64(3).QuoUint64(2)
-		nodeAccount.Bond = common.SafeSub(nodeAccount.Bond, slashAmount)
+		nodeAccount.SubBond(slashAmount)
+		if err := addSlashRecord(ctx, s.keeper, nodeAccount.NodeAddress, SlashReasonExcessOutbound, 0, slashAmount); err != nil {
+			ctx.Logger().Error("fail to add slash record", "error", err)
+		}
 		vaultData, err := s.keeper.GetVaultData(ctx)
 		if err != nil {
 			return fmt.Errorf("fail to get vault data: %w", err)
@@ -276,7 +311,10 @@ func (s *Slasher) SlashNodeAccount(ctx sdk.Context, observedPubKey common.PubKey
 	runeValue := pool.AssetValueInRune(slashAmount).MulUint64(3).QuoUint64(2)
 	pool.BalanceAsset = common.SafeSub(pool.BalanceAsset, slashAmount)
 	pool.BalanceRune = pool.BalanceRune.Add(runeValue)
-	nodeAccount.Bond = common.SafeSub(nodeAccount.Bond, runeValue)
+	nodeAccount.SubBond(runeValue)
+	if err := addSlashRecord(ctx, s.keeper, nodeAccount.NodeAddress, SlashReasonExcessOutbound, 0, runeValue); err != nil {
+		ctx.Logger().Error("fail to add slash record", "error", err)
+	}
 	if err := s.keeper.SetPool(ctx, pool); err != nil {
 		return fmt.Errorf("fail to save %s pool: %w", asset, err)
 	}
@@ -303,3 +341,49 @@ func (s *Slasher) SlashNodeAccount(ctx sdk.Context, observedPubKey common.PubKey
 
 	return s.keeper.SetNodeAccount(ctx, nodeAccount)
 }
+
+// DecaySlashPoints halves the slash points of every node account once per
+// half-life, the half-life is set by mimir, slash points don't decay when it is not set
+func (s *Slasher) DecaySlashPoints(ctx sdk.Context, constAccessor constants.ConstantValues) error {
+	halfLife, err := s.keeper.GetMimir(ctx, constants.SlashPointHalfLife.String())
+	if halfLife < 0 || err != nil {
+		halfLife = constAccessor.GetInt64Value(constants.SlashPointHalfLife)
+	}
+	if halfLife <= 0 || ctx.BlockHeight()%halfLife != 0 {
+		return nil
+	}
+	iter := s.keeper.GetNodeAccountIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var na NodeAccount
+		if err := s.keeper.Cdc().UnmarshalBinaryBare(iter.Value(), &na); err != nil {
+			return fmt.Errorf("fail to unmarshal node account: %w", err)
+		}
+		pts, err := s.keeper.GetNodeAccountSlashPoints(ctx, na.NodeAddress)
+		if err != nil {
+			return fmt.Errorf("fail to get node account slash points: %w", err)
+		}
+		if pts <= 0 {
+			continue
+		}
+		s.keeper.SetNodeAccountSlashPoints(ctx, na.NodeAddress, pts/2)
+	}
+	return nil
+}
+
+// incSlashPoints add slash points to the given node account, and record it in the node account's slash ledger
+func incSlashPoints(ctx sdk.Context, keeper Keeper, addr sdk.AccAddress, pts int64, reason SlashReason) error {
+	if err := keeper.IncNodeAccountSlashPoints(ctx, addr, pts); err != nil {
+		return fmt.Errorf("fail to inc slash points: %w", err)
+	}
+	return addSlashRecord(ctx, keeper, addr, reason, pts, sdk.ZeroUint())
+}
+
+// addSlashRecord record a slash in the node account's slash ledger
+func addSlashRecord(ctx sdk.Context, keeper Keeper, addr sdk.AccAddress, reason SlashReason, pts int64, bond sdk.Uint) error {
+	record := NewSlashRecord(ctx.BlockHeight(), reason, pts, bond)
+	if err := keeper.AddNodeAccountSlashRecord(ctx, addr, record); err != nil {
+		return fmt.Errorf("fail to add slash record: %w", err)
+	}
+	return nil
+}
@@ -475,14 +475,75 @@ func (s *SlashingSuite) TestDoubleSign(c *C) {
 		na:        na,
 		vaultData: NewVaultData(),
 	}
-	slasher, err := NewSlasher(keeper, constants.SWVersion, NewVersionedEventMgr())
+	slasher, err := NewSlasher(keeper, constants.SWVersion, NewDummyVersionedEventMgr())
 	c.Assert(err, IsNil)
 
 	pk, err := sdk.GetConsPubKeyBech32(na.ValidatorConsPubKey)
 	c.Assert(err, IsNil)
-	err = slasher.HandleDoubleSign(ctx, pk.Address(), 0, constAccessor)
+	validatorMgr := &TestDoubleSignValidatorMgr{}
+	err = slasher.HandleDoubleSign(ctx, pk.Address(), 0, constAccessor, validatorMgr)
 	c.Assert(err, IsNil)
 
 	c.Check(keeper.na.Bond.Equal(sdk.NewUint(9995000000)), Equals, true, Commentf("%d", keeper.na.Bond.Uint64()))
 	c.Check(keeper.vaultData.TotalReserve.Equal(sdk.NewUint(5000000)), Equals, true)
+	c.Check(keeper.na.ForcedToLeave, Equals, true)
+	c.Check(keeper.na.LeaveHeight, Equals, ctx.BlockHeight())
+	c.Assert(validatorMgr.yggReturns, HasLen, 1)
+	c.Check(validatorMgr.yggReturns[0].Equals(na.NodeAddress), Equals, true)
+}
+
+type TestDoubleSignValidatorMgr struct {
+	VersionedValidatorDummyMgr
+	yggReturns []sdk.AccAddress
+}
+
+func (vm *TestDoubleSignValidatorMgr) RequestYggReturn(ctx sdk.Context, version semver.Version, node NodeAccount) error {
+	vm.yggReturns = append(vm.yggReturns, node.NodeAddress)
+	return nil
+}
+
+func (s *SlashingSuite) TestSlashRecordsAndDecay(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+
+	nas := NodeAccounts{
+		GetRandomNodeAccount(NodeActive),
+		GetRandomNodeAccount(NodeActive),
+	}
+	for _, na := range nas {
+		c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	}
+	c.Assert(k.AddObservingAddresses(ctx, []sdk.AccAddress{nas[0].NodeAddress}), IsNil)
+
+	slasher, err := NewSlasher(k, constants.SWVersion, NewVersionedEventMgr())
+	c.Assert(err, IsNil)
+	c.Assert(slasher.LackObserving(ctx, constAccessor), IsNil)
+
+	penalty := constAccessor.GetInt64Value(constants.LackOfObservationPenalty)
+	records, err := k.GetNodeAccountSlashRecords(ctx, nas[1].NodeAddress)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 1)
+	c.Check(records[0].Reason, Equals, SlashReasonLackOfObservation)
+	c.Check(records[0].SlashPoints, Equals, penalty)
+	c.Check(records[0].Height, Equals, ctx.BlockHeight())
+	records, err = k.GetNodeAccountSlashRecords(ctx, nas[0].NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(records, HasLen, 0)
+
+	// no decay when half-life is not set
+	k.SetNodeAccountSlashPoints(ctx, nas[1].NodeAddress, 100)
+	c.Assert(slasher.DecaySlashPoints(ctx, constAccessor), IsNil)
+	pts, err := k.GetNodeAccountSlashPoints(ctx, nas[1].NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(pts, Equals, int64(100))
+
+	k.SetMimir(ctx, constants.SlashPointHalfLife.String(), 10)
+	c.Assert(slasher.DecaySlashPoints(ctx.WithBlockHeight(11), constAccessor), IsNil)
+	pts, err = k.GetNodeAccountSlashPoints(ctx, nas[1].NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(pts, Equals, int64(100))
+	c.Assert(slasher.DecaySlashPoints(ctx.WithBlockHeight(20), constAccessor), IsNil)
+	pts, err = k.GetNodeAccountSlashPoints(ctx, nas[1].NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(pts, Equals, int64(50))
 }
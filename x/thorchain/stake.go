@@ -82,12 +82,8 @@ func stake(ctx sdk.Context, keeper Keeper,
 		}
 	}
 
-	if !asset.Chain.IsBNB() {
-		if stakeAssetAmount.IsZero() {
-			su.PendingRune = su.PendingRune.Add(stakeRuneAmount)
-			keeper.SetStaker(ctx, su)
-			return sdk.ZeroUint(), nil
-		}
+	if !asset.Chain.IsBNB() && !su.PendingRune.IsZero() {
+		// RUNE left pending on the staker before pending liquidity records were introduced
 		stakeRuneAmount = su.PendingRune.Add(stakeRuneAmount)
 		su.PendingRune = sdk.ZeroUint()
 	}
@@ -129,6 +125,64 @@ func stake(ctx sdk.Context, keeper Keeper,
 	return stakerUnits, nil
 }
 
+// emitPendingLiquidityStakeEvents emit a stake event for every tx that made up the pending liquidity,
+// the stake units are reported on the last one
+func emitPendingLiquidityStakeEvents(ctx sdk.Context, keeper Keeper, eventMgr EventManager, pending PendingLiquidity, stakeUnits sdk.Uint) error {
+	for i, tx := range pending.Txs {
+		units := sdk.ZeroUint()
+		if i == len(pending.Txs)-1 {
+			units = stakeUnits
+		}
+		if err := eventMgr.EmitStakeEvent(ctx, keeper, tx, NewEventStake(pending.Asset, units, tx)); err != nil {
+			return fmt.Errorf("fail to emit stake event: %w", err)
+		}
+	}
+	return nil
+}
+
+// processExpiredPendingLiquidity deal with the pending liquidity that waited too long for the other side of the stake,
+// the pending side is staked asymmetrically when the pool is enabled, otherwise it is refunded
+func processExpiredPendingLiquidity(ctx sdk.Context, keeper Keeper, txOutStore TxOutStore, eventMgr EventManager, constAccessor constants.ConstantValues) {
+	expired, err := keeper.GetExpiredPendingLiquidity(ctx, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("fail to get expired pending liquidity", "error", err)
+		return
+	}
+	for _, pending := range expired {
+		// each pending liquidity is dealt with atomically, on failure it is retried in the next block
+		cacheCtx, commit := ctx.CacheContext()
+		if err := commitOrRefundPendingLiquidity(cacheCtx, keeper, txOutStore, eventMgr, pending, constAccessor); err != nil {
+			ctx.Logger().Error("fail to process expired pending liquidity", "pending", pending, "error", err)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+func commitOrRefundPendingLiquidity(ctx sdk.Context, keeper Keeper, txOutStore TxOutStore, eventMgr EventManager, pending PendingLiquidity, constAccessor constants.ConstantValues) error {
+	keeper.RemovePendingLiquidity(ctx, pending)
+	pool, err := keeper.GetPool(ctx, pending.Asset)
+	if err != nil {
+		return fmt.Errorf("fail to get pool(%s): %w", pending.Asset, err)
+	}
+	if pool.Status == PoolEnabled {
+		stakeUnits, err := stake(ctx, keeper, pending.Asset, pending.PendingRune, pending.PendingAsset, pending.RuneAddress, pending.AssetAddress, pending.Txs[0].ID, constAccessor)
+		if err == nil {
+			return emitPendingLiquidityStakeEvents(ctx, keeper, eventMgr, pending, stakeUnits)
+		}
+		ctx.Logger().Error("fail to stake pending liquidity, refund it", "pending", pending, "error", err)
+	}
+	for _, tx := range pending.Txs {
+		// no vault is given, the tx out store will pick one to send the refund from
+		observedTx := NewObservedTx(tx, ctx.BlockHeight(), common.EmptyPubKey)
+		if err := refundTx(ctx, observedTx, txOutStore, keeper, constAccessor, CodeStakePendingExpired, "the other side of the stake never arrived", eventMgr); err != nil {
+			return fmt.Errorf("fail to refund pending liquidity: %w", err)
+		}
+	}
+	return nil
+}
+
 // calculatePoolUnits calculate the pool units and staker units
 // returns newPoolUnit,stakerUnit, error
 func calculatePoolUnits(oldPoolUnits, poolRune, poolAsset, stakeRune, stakeAsset sdk.Uint) (sdk.Uint, sdk.Uint, error) {
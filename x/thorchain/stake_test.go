@@ -239,10 +239,11 @@ func (StakeSuite) TestStake(c *C) {
 		Status:       PoolEnabled,
 	}), IsNil)
 
-	// stake rune
-	stakerUnit, err = stake(ctx, ps, common.BTCAsset, sdk.NewUint(100*common.One), sdk.ZeroUint(), bnbAddress, btcAddress, txID, constAccessor)
+	// rune left pending on the staker by an older version is staked together with the btc
+	su, err := ps.GetStaker(ctx, common.BTCAsset, bnbAddress)
 	c.Assert(err, IsNil)
-	c.Check(stakerUnit.IsZero(), Equals, true)
+	su.PendingRune = sdk.NewUint(100 * common.One)
+	ps.SetStaker(ctx, su)
 	// stake btc
 	stakerUnit, err = stake(ctx, ps, common.BTCAsset, sdk.ZeroUint(), sdk.NewUint(100*common.One), bnbAddress, btcAddress, txID, constAccessor)
 	c.Assert(err, IsNil)
@@ -252,4 +253,64 @@ func (StakeSuite) TestStake(c *C) {
 	c.Check(p.BalanceAsset.Equal(sdk.NewUint(100*common.One)), Equals, true, Commentf("%d", p.BalanceAsset.Uint64()))
 	c.Check(p.BalanceRune.Equal(sdk.NewUint(100*common.One)), Equals, true, Commentf("%d", p.BalanceRune.Uint64()))
 	c.Check(p.PoolUnits.Equal(sdk.NewUint(100*common.One)), Equals, true, Commentf("%d", p.PoolUnits.Uint64()))
+	su, err = ps.GetStaker(ctx, common.BTCAsset, bnbAddress)
+	c.Assert(err, IsNil)
+	c.Check(su.PendingRune.IsZero(), Equals, true)
+}
+
+func (StakeSuite) TestProcessExpiredPendingLiquidity(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	txOutStore := NewTxStoreDummy()
+
+	btcPool := NewPool()
+	btcPool.Asset = common.BTCAsset
+	btcPool.BalanceRune = sdk.NewUint(100 * common.One)
+	btcPool.BalanceAsset = sdk.NewUint(100 * common.One)
+	btcPool.PoolUnits = sdk.NewUint(100 * common.One)
+	btcPool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, btcPool), IsNil)
+	ethAsset, err := common.NewAsset("ETH.ETH")
+	c.Assert(err, IsNil)
+	ethPool := NewPool()
+	ethPool.Asset = ethAsset
+	ethPool.Status = PoolBootstrap
+	c.Assert(k.SetPool(ctx, ethPool), IsNil)
+
+	newPending := func(asset common.Asset, expire int64) PendingLiquidity {
+		runeAddr := GetRandomBNBAddress()
+		pending := NewPendingLiquidity(asset, runeAddr, GetRandomBTCAddress())
+		tx := GetRandomTx()
+		tx.FromAddress = runeAddr
+		tx.Coins = common.Coins{common.NewCoin(common.RuneAsset(), sdk.NewUint(10*common.One))}
+		pending.Add(sdk.NewUint(10*common.One), sdk.ZeroUint(), tx)
+		pending.ExpireBlockHeight = expire
+		c.Assert(k.SetPendingLiquidity(ctx, pending), IsNil)
+		return pending
+	}
+	staked := newPending(common.BTCAsset, 10)
+	refunded := newPending(ethAsset, 5)
+	waiting := newPending(common.BTCAsset, 11)
+
+	processExpiredPendingLiquidity(ctx, k, txOutStore, NewEventMgr(), constAccessor)
+
+	// the pool is enabled, the rune is staked asymmetrically
+	su, err := k.GetStaker(ctx, common.BTCAsset, staked.RuneAddress)
+	c.Assert(err, IsNil)
+	c.Check(su.Units.IsZero(), Equals, false)
+	p, err := k.GetPool(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(p.BalanceRune.Equal(sdk.NewUint(110*common.One)), Equals, true, Commentf("%d", p.BalanceRune.Uint64()))
+
+	// the pool is not enabled, the rune is refunded
+	items := txOutStore.GetOutboundItemByToAddress(refunded.RuneAddress)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Coin.Equals(refunded.Txs[0].Coins[0]), Equals, true)
+
+	for _, pending := range []PendingLiquidity{staked, refunded, waiting} {
+		stored, err := k.GetPendingLiquidity(ctx, pending.Asset, pending.RuneAddress, pending.AssetAddress)
+		c.Assert(err, IsNil)
+		c.Check(stored.IsEmpty(), Equals, pending.ExpireBlockHeight <= ctx.BlockHeight())
+	}
 }
@@ -45,23 +45,23 @@ func (s SwapQueueSuite) TestScoreMsgs(c *C) {
 		NewMsgSwap(common.Tx{
 			ID:    GetRandomTxHash(),
 			Coins: common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(2*common.One))},
-		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr()),
+		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr()),
 		NewMsgSwap(common.Tx{
 			ID:    GetRandomTxHash(),
 			Coins: common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(50*common.One))},
-		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr()),
+		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr()),
 		NewMsgSwap(common.Tx{
 			ID:    GetRandomTxHash(),
 			Coins: common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(1*common.One))},
-		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr()),
+		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr()),
 		NewMsgSwap(common.Tx{
 			ID:    GetRandomTxHash(),
 			Coins: common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(100*common.One))},
-		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr()),
+		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr()),
 		NewMsgSwap(common.Tx{
 			ID:    GetRandomTxHash(),
 			Coins: common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(10*common.One))},
-		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr()),
+		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr()),
 	}
 
 	swaps, err := queue.ScoreMsgs(ctx, msgs)
@@ -79,43 +79,43 @@ func (s SwapQueueSuite) TestScoreMsgs(c *C) {
 		NewMsgSwap(common.Tx{
 			ID:    GetRandomTxHash(),
 			Coins: common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(2*common.One))},
-		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr()),
+		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr()),
 		NewMsgSwap(common.Tx{
 			ID:    GetRandomTxHash(),
 			Coins: common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(50*common.One))},
-		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr()),
+		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr()),
 		NewMsgSwap(common.Tx{
 			ID:    GetRandomTxHash(),
 			Coins: common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(1*common.One))},
-		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr()),
+		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr()),
 		NewMsgSwap(common.Tx{
 			ID:    GetRandomTxHash(),
 			Coins: common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(100*common.One))},
-		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr()),
+		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr()),
 		NewMsgSwap(common.Tx{
 			ID:    GetRandomTxHash(),
 			Coins: common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(10*common.One))},
-		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr()),
+		}, common.BNBAsset, GetRandomBNBAddress(), sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr()),
 		NewMsgSwap(common.Tx{
 			ID:    GetRandomTxHash(),
 			Coins: common.Coins{common.NewCoin(common.BTCAsset, sdk.NewUint(2*common.One))},
-		}, common.BTCAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr()),
+		}, common.BTCAsset, GetRandomBNBAddress(), sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr()),
 		NewMsgSwap(common.Tx{
 			ID:    GetRandomTxHash(),
 			Coins: common.Coins{common.NewCoin(common.BTCAsset, sdk.NewUint(50*common.One))},
-		}, common.BTCAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr()),
+		}, common.BTCAsset, GetRandomBNBAddress(), sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr()),
 		NewMsgSwap(common.Tx{
 			ID:    GetRandomTxHash(),
 			Coins: common.Coins{common.NewCoin(common.BTCAsset, sdk.NewUint(1*common.One))},
-		}, common.BTCAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr()),
+		}, common.BTCAsset, GetRandomBNBAddress(), sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr()),
 		NewMsgSwap(common.Tx{
 			ID:    GetRandomTxHash(),
 			Coins: common.Coins{common.NewCoin(common.BTCAsset, sdk.NewUint(100*common.One))},
-		}, common.BTCAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr()),
+		}, common.BTCAsset, GetRandomBNBAddress(), sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr()),
 		NewMsgSwap(common.Tx{
 			ID:    GetRandomTxHash(),
 			Coins: common.Coins{common.NewCoin(common.BTCAsset, sdk.NewUint(10*common.One))},
-		}, common.BTCAsset, GetRandomBNBAddress(), sdk.ZeroUint(), GetRandomBech32Addr()),
+		}, common.BTCAsset, GetRandomBNBAddress(), sdk.ZeroUint(), common.NoAddress, sdk.ZeroUint(), GetRandomBech32Addr()),
 	}
 
 	swaps, err = queue.ScoreMsgs(ctx, msgs)
@@ -226,6 +226,12 @@ func (s *ThorchainSuite) TestChurn(c *C) {
 	c.Check(item.Coin.Amount.Uint64(), Equals, uint64(2000000000), Commentf("%d", item.Coin.Amount.Uint64()))
 	item = items[1]
 	c.Check(item.Coin.Amount.Uint64(), Equals, uint64(1579925000), Commentf("%d", item.Coin.Amount.Uint64()))
+	plan, err := keeper.GetMigrationPlan(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(plan.StartHeight, Equals, ctx.BlockHeight())
+	c.Assert(plan.Items, HasLen, 2)
+	c.Check(plan.Items[0].TxIssued, Equals, int64(1))
+	c.Check(plan.Items[1].TxIssued, Equals, int64(1))
 	// check we empty the rest at the last migration event
 	migrateInterval := consts.GetInt64Value(constants.FundMigrationInterval)
 	ctx = ctx.WithBlockHeight(vault.StatusSince + (migrateInterval * 7))
@@ -241,6 +247,20 @@ func (s *ThorchainSuite) TestChurn(c *C) {
 	c.Check(item.Coin.Amount.Uint64(), Equals, uint64(10000000000), Commentf("%d", item.Coin.Amount.Uint64()))
 	item = items[3]
 	c.Check(item.Coin.Amount.Uint64(), Equals, uint64(7899925000), Commentf("%d", item.Coin.Amount.Uint64()))
+
+	// observed migration txs are recorded in the migration plan
+	tx := GetRandomTx()
+	tx.Coins = common.Coins{items[2].Coin}
+	tx.Gas = common.BNBGasFeeSingleton
+	c.Assert(recordMigration(ctx, keeper, vault, tx), IsNil)
+	plan, err = keeper.GetMigrationPlan(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	item = items[2]
+	migrated := plan.GetItem(item.Coin.Asset)
+	c.Check(migrated.TxIssued, Equals, int64(2))
+	c.Check(migrated.TxConfirmed, Equals, int64(1))
+	c.Check(migrated.Migrated.Equal(item.Coin.Amount), Equals, true)
+	c.Check(plan.GasSpent.Equals(common.BNBGasFeeSingleton), Equals, true)
 }
 
 func (s *ThorchainSuite) TestRagnarok(c *C) {
@@ -145,3 +145,69 @@ func (s TxOutStoreSuite) TestAddOutTxItemWithoutBFT(c *C) {
 	c.Assert(msgs, HasLen, 1)
 	c.Assert(msgs[0].Coin.Amount.Equal(sdk.NewUint(19*common.One)), Equals, true)
 }
+
+func (s TxOutStoreSuite) TestAddOutTxItemMultipleAsgards(c *C) {
+	w := getHandlerTestWrapper(c, 1, true, true)
+	asgard1 := GetRandomVault()
+	asgard1.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(100*common.One)),
+	}
+	c.Assert(w.keeper.SetVault(w.ctx, asgard1), IsNil)
+	asgard2 := GetRandomVault()
+	asgard2.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(80*common.One)),
+	}
+	c.Assert(w.keeper.SetVault(w.ctx, asgard2), IsNil)
+
+	txOutStore, err := w.versionedTxOutStore.GetTxOutStore(w.ctx, w.keeper, constants.SWVersion)
+	c.Assert(err, IsNil)
+	for _, pk := range []common.PubKey{asgard1.PubKey, asgard2.PubKey} {
+		item := &TxOutItem{
+			Chain:     common.BNBChain,
+			ToAddress: GetRandomBNBAddress(),
+			InHash:    GetRandomTxHash(),
+			Coin:      common.NewCoin(common.BNBAsset, sdk.NewUint(50*common.One)),
+		}
+		success, err := txOutStore.TryAddTxOutItem(w.ctx, item)
+		c.Assert(err, IsNil)
+		c.Assert(success, Equals, true)
+		// the second outbound goes to asgard2, since asgard1 only has 51 BNB
+		// left after the first outbound
+		c.Check(item.VaultPubKey.Equals(pk), Equals, true)
+	}
+}
+
+func (s TxOutStoreSuite) TestAddOutTxItemWithNetworkFee(c *C) {
+	w := getHandlerTestWrapper(c, 1, true, true)
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BTCAsset, sdk.NewUint(100*common.One)),
+	}
+	c.Assert(w.keeper.SetVault(w.ctx, vault), IsNil)
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceAsset = sdk.NewUint(100 * common.One)
+	pool.BalanceRune = sdk.NewUint(100 * common.One)
+	pool.PoolUnits = sdk.NewUint(100)
+	c.Assert(w.keeper.SetPool(w.ctx, pool), IsNil)
+	c.Assert(w.keeper.SaveNetworkFee(w.ctx, common.BTCChain, NewNetworkFee(common.BTCChain, 250, 10)), IsNil)
+
+	txOutStore, err := w.versionedTxOutStore.GetTxOutStore(w.ctx, w.keeper, constants.SWVersion)
+	c.Assert(err, IsNil)
+	addr, err := GetRandomPubKey().GetAddress(common.BTCChain)
+	c.Assert(err, IsNil)
+	item := &TxOutItem{
+		Chain:     common.BTCChain,
+		ToAddress: addr,
+		InHash:    GetRandomTxHash(),
+		Coin:      common.NewCoin(common.BTCAsset, sdk.NewUint(20*common.One)),
+	}
+	success, err := txOutStore.TryAddTxOutItem(w.ctx, item)
+	c.Assert(err, IsNil)
+	c.Assert(success, Equals, true)
+	c.Assert(item.MaxGas, HasLen, 1)
+	c.Check(item.MaxGas[0].Asset.Equals(common.BTCAsset), Equals, true)
+	c.Check(item.MaxGas[0].Amount.Equal(sdk.NewUint(2500)), Equals, true)
+	// the signer pays the agreed fee rate for the actual size of the outbound
+	c.Check(item.GasRate, Equals, int64(10))
+}
@@ -118,6 +118,14 @@ func (tos *TxOutStorageV1) prepareTxOutItem(ctx sdk.Context, toi *TxOutItem) (bo
 			ctx.Logger().Error("fail to get active vaults", "error", err)
 		}
 
+		// with multiple asgard vaults, spread the outbounds across them based
+		// on the funds each vault has left after the outbounds already
+		// scheduled in this block
+		active, err = tos.deductPendingOutbounds(ctx, active)
+		if err != nil {
+			return false, fmt.Errorf("fail to deduct pending outbounds: %w", err)
+		}
+
 		vault := active.SelectByMaxCoin(toi.Coin.Asset)
 		if vault.IsEmpty() {
 			return false, fmt.Errorf("empty vault, cannot send out fund: %w", err)
@@ -144,6 +152,18 @@ func (tos *TxOutStorageV1) prepareTxOutItem(ctx sdk.Context, toi *TxOutItem) (bo
 	}
 
 	transactionFee := tos.constAccessor.GetInt64Value(constants.TransactionFee)
+	if toi.MaxGas.IsEmpty() {
+		networkFee, err := tos.keeper.GetNetworkFee(ctx, toi.Chain)
+		if err != nil {
+			return false, fmt.Errorf("fail to get network fee of chain(%s): %w", toi.Chain, err)
+		}
+		// once the active nodes agreed on a fee rate, every signer pays that rate for the outbound, MaxGas is the gas of a
+		// typical outbound tx at that rate
+		if !networkFee.IsEmpty() {
+			toi.MaxGas = networkFee.GetGas()
+			toi.GasRate = int64(networkFee.TransactionFeeRate)
+		}
+	}
 	if toi.MaxGas.IsEmpty() {
 		gasAsset := toi.Chain.GetGasAsset()
 		pool, err := tos.keeper.GetPool(ctx, gasAsset)
@@ -235,6 +255,23 @@ func (tos *TxOutStorageV1) prepareTxOutItem(ctx sdk.Context, toi *TxOutItem) (bo
 	return true, nil
 }
 
+// deductPendingOutbounds subtract the outbound items that are already scheduled
+// in the current block from the coins of the given vaults
+func (tos *TxOutStorageV1) deductPendingOutbounds(ctx sdk.Context, vaults Vaults) (Vaults, error) {
+	block, err := tos.keeper.GetTxOut(ctx, tos.height)
+	if err != nil {
+		return nil, err
+	}
+	for i := range vaults {
+		for _, item := range block.TxArray {
+			if item.OutHash.IsEmpty() && item.VaultPubKey.Equals(vaults[i].PubKey) {
+				vaults[i].SubFunds(common.Coins{item.Coin})
+			}
+		}
+	}
+	return vaults, nil
+}
+
 func (tos *TxOutStorageV1) addToBlockOut(ctx sdk.Context, toi *TxOutItem) error {
 	if toi.Coin.IsNative() {
 		return tos.nativeTxOut(ctx, toi)
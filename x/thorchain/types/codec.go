@@ -23,6 +23,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgAdd{}, "thorchain/MsgAdd", nil)
 	cdc.RegisterConcrete(MsgBond{}, "thorchain/MsgBond", nil)
 	cdc.RegisterConcrete(MsgLeave{}, "thorchain/MsgLeave", nil)
+	cdc.RegisterConcrete(MsgUnbond{}, "thorchain/MsgUnbond", nil)
 	cdc.RegisterConcrete(MsgNoOp{}, "thorchain/MsgNoOp", nil)
 	cdc.RegisterConcrete(MsgOutboundTx{}, "thorchain/MsgOutboundTx", nil)
 	cdc.RegisterConcrete(MsgSetVersion{}, "thorchain/MsgSetVersion", nil)
@@ -33,4 +34,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgBan{}, "thorchain/MsgBan", nil)
 	cdc.RegisterConcrete(MsgSwitch{}, "thorchain/MsgSwitch", nil)
 	cdc.RegisterConcrete(MsgMimir{}, "thorchain/MsgMimir", nil)
+	cdc.RegisterConcrete(MsgNetworkFee{}, "thorchain/MsgNetworkFee", nil)
+	cdc.RegisterConcrete(MsgRegisterMemo{}, "thorchain/MsgRegisterMemo", nil)
+	cdc.RegisterConcrete(MsgManageTHORName{}, "thorchain/MsgManageTHORName", nil)
 }
@@ -7,22 +7,27 @@ import (
 )
 
 // MsgBond when a user would like to become a validator, and run a full set, they need send an `apply:bepaddress` with a bond to our pool address
+// the node operator can also whitelist a bond provider, and set the fee they charge on their bond providers' rewards
 type MsgBond struct {
-	TxIn        common.Tx      `json:"tx_in"`
-	NodeAddress sdk.AccAddress `json:"node_address"`
-	Bond        sdk.Uint       `json:"bond"`
-	BondAddress common.Address `json:"bond_address"`
-	Signer      sdk.AccAddress `json:"signer"`
+	TxIn                common.Tx      `json:"tx_in"`
+	NodeAddress         sdk.AccAddress `json:"node_address"`
+	Bond                sdk.Uint       `json:"bond"`
+	BondAddress         common.Address `json:"bond_address"`
+	BondProviderAddress common.Address `json:"bond_provider_address"`
+	OperatorFee         int64          `json:"operator_fee"`
+	Signer              sdk.AccAddress `json:"signer"`
 }
 
 // NewMsgBond create new MsgBond message
-func NewMsgBond(txin common.Tx, nodeAddr sdk.AccAddress, bond sdk.Uint, bondAddress common.Address, signer sdk.AccAddress) MsgBond {
+func NewMsgBond(txin common.Tx, nodeAddr sdk.AccAddress, bond sdk.Uint, bondAddress, bondProviderAddress common.Address, operatorFee int64, signer sdk.AccAddress) MsgBond {
 	return MsgBond{
-		TxIn:        txin,
-		NodeAddress: nodeAddr,
-		Bond:        bond,
-		BondAddress: bondAddress,
-		Signer:      signer,
+		TxIn:                txin,
+		NodeAddress:         nodeAddr,
+		Bond:                bond,
+		BondAddress:         bondAddress,
+		BondProviderAddress: bondProviderAddress,
+		OperatorFee:         operatorFee,
+		Signer:              signer,
 	}
 }
 
@@ -49,6 +54,9 @@ func (msg MsgBond) ValidateBasic() sdk.Error {
 	if msg.Signer.Empty() {
 		return sdk.ErrInvalidAddress("empty signer address")
 	}
+	if msg.OperatorFee > MaxOperatorFeeBasisPoints {
+		return sdk.ErrUnknownRequest("operator fee cannot be more than 10000 basis points")
+	}
 	return nil
 }
 
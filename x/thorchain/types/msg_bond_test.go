@@ -23,16 +23,18 @@ func (MsgApplySuite) TestMsgApply(c *C) {
 	txin := GetRandomTx()
 	txinNoID := txin
 	txinNoID.ID = ""
-	msgApply := NewMsgBond(txin, nodeAddr, sdk.NewUint(common.One), bondAddr, signerAddr)
+	msgApply := NewMsgBond(txin, nodeAddr, sdk.NewUint(common.One), bondAddr, common.NoAddress, -1, signerAddr)
 	c.Assert(msgApply.ValidateBasic(), IsNil)
 	c.Assert(msgApply.Route(), Equals, RouterKey)
 	c.Assert(msgApply.Type(), Equals, "validator_apply")
 	c.Assert(msgApply.GetSignBytes(), NotNil)
 	c.Assert(len(msgApply.GetSigners()), Equals, 1)
 	c.Assert(msgApply.GetSigners()[0].Equals(signerAddr), Equals, true)
-	c.Assert(NewMsgBond(txin, sdk.AccAddress{}, sdk.NewUint(common.One), bondAddr, signerAddr).ValidateBasic(), NotNil)
-	c.Assert(NewMsgBond(txin, nodeAddr, sdk.ZeroUint(), bondAddr, signerAddr).ValidateBasic(), NotNil)
-	c.Assert(NewMsgBond(txinNoID, nodeAddr, sdk.NewUint(common.One), bondAddr, signerAddr).ValidateBasic(), NotNil)
-	c.Assert(NewMsgBond(txin, nodeAddr, sdk.NewUint(common.One), "", signerAddr).ValidateBasic(), NotNil)
-	c.Assert(NewMsgBond(txin, nodeAddr, sdk.NewUint(common.One), bondAddr, sdk.AccAddress{}).ValidateBasic(), NotNil)
+	c.Assert(NewMsgBond(txin, sdk.AccAddress{}, sdk.NewUint(common.One), bondAddr, common.NoAddress, -1, signerAddr).ValidateBasic(), NotNil)
+	c.Assert(NewMsgBond(txin, nodeAddr, sdk.ZeroUint(), bondAddr, common.NoAddress, -1, signerAddr).ValidateBasic(), NotNil)
+	c.Assert(NewMsgBond(txinNoID, nodeAddr, sdk.NewUint(common.One), bondAddr, common.NoAddress, -1, signerAddr).ValidateBasic(), NotNil)
+	c.Assert(NewMsgBond(txin, nodeAddr, sdk.NewUint(common.One), "", common.NoAddress, -1, signerAddr).ValidateBasic(), NotNil)
+	c.Assert(NewMsgBond(txin, nodeAddr, sdk.NewUint(common.One), bondAddr, common.NoAddress, -1, sdk.AccAddress{}).ValidateBasic(), NotNil)
+	c.Assert(NewMsgBond(txin, nodeAddr, sdk.NewUint(common.One), bondAddr, GetRandomBNBAddress(), 2000, signerAddr).ValidateBasic(), IsNil)
+	c.Assert(NewMsgBond(txin, nodeAddr, sdk.NewUint(common.One), bondAddr, GetRandomBNBAddress(), 10001, signerAddr).ValidateBasic(), NotNil)
 }
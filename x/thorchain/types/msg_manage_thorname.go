@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// MsgManageTHORName defines a MsgManageTHORName message, it registers or renews a THORName and sets its address on a chain
+type MsgManageTHORName struct {
+	Name    string         `json:"name"`
+	Chain   common.Chain   `json:"chain"`
+	Address common.Address `json:"address"`
+	Coin    common.Coin    `json:"coin"` // RUNE paid for the registration, every TNSFeePerBlock extends it by one block
+	Signer  sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgManageTHORName is a constructor function for MsgManageTHORName
+func NewMsgManageTHORName(name string, chain common.Chain, addr common.Address, coin common.Coin, signer sdk.AccAddress) MsgManageTHORName {
+	return MsgManageTHORName{
+		Name:    name,
+		Chain:   chain,
+		Address: addr,
+		Coin:    coin,
+		Signer:  signer,
+	}
+}
+
+// Route should return the cmname of the module
+func (msg MsgManageTHORName) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgManageTHORName) Type() string { return "manage_thorname" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgManageTHORName) ValidateBasic() sdk.Error {
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+	if !IsValidTHORName(msg.Name) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("invalid THORName: %s", msg.Name))
+	}
+	if msg.Chain.IsEmpty() {
+		return sdk.ErrUnknownRequest("chain can't be empty")
+	}
+	if !msg.Address.IsEmpty() && !msg.Address.IsChain(msg.Chain) {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("%s is not a %s address", msg.Address, msg.Chain))
+	}
+	if !msg.Coin.Asset.Equals(common.RuneNative) {
+		return sdk.ErrInvalidCoins("THORName can only be paid with native RUNE")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgManageTHORName) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgManageTHORName) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type MsgManageTHORNameSuite struct{}
+
+var _ = Suite(&MsgManageTHORNameSuite{})
+
+func (MsgManageTHORNameSuite) TestMsgManageTHORName(c *C) {
+	acc1 := GetRandomBech32Addr()
+	bnbAddr := GetRandomBNBAddress()
+	coin := common.NewCoin(common.RuneNative, sdk.NewUint(10*common.One))
+	msg := NewMsgManageTHORName("bob", common.BNBChain, bnbAddr, coin, acc1)
+	c.Assert(msg.Route(), Equals, RouterKey)
+	c.Assert(msg.Type(), Equals, "manage_thorname")
+	c.Assert(msg.ValidateBasic(), IsNil)
+	c.Assert(len(msg.GetSignBytes()) > 0, Equals, true)
+	c.Assert(msg.GetSigners(), NotNil)
+	c.Assert(msg.GetSigners()[0].String(), Equals, acc1.String())
+
+	// renewal only, without setting an address
+	msg = NewMsgManageTHORName("bob", common.BNBChain, common.NoAddress, coin, acc1)
+	c.Assert(msg.ValidateBasic(), IsNil)
+
+	inputs := []struct {
+		name   string
+		chain  common.Chain
+		addr   common.Address
+		coin   common.Coin
+		signer sdk.AccAddress
+	}{
+		{"bob", common.BNBChain, bnbAddr, coin, sdk.AccAddress{}},
+		{"", common.BNBChain, bnbAddr, coin, acc1},
+		{"bob:alice", common.BNBChain, bnbAddr, coin, acc1},
+		{"bob", common.EmptyChain, bnbAddr, coin, acc1},
+		{"bob", common.BTCChain, bnbAddr, coin, acc1},
+		{"bob", common.BNBChain, bnbAddr, common.NewCoin(common.BNBAsset, sdk.NewUint(common.One)), acc1},
+	}
+	for _, item := range inputs {
+		msg := NewMsgManageTHORName(item.name, item.chain, item.addr, item.coin, item.signer)
+		c.Assert(msg.ValidateBasic(), NotNil)
+	}
+}
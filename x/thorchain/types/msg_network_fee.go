@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// MsgNetworkFee defines a MsgNetworkFee message, bifrost use it to report the fee rate of a chain it observed
+type MsgNetworkFee struct {
+	BlockHeight        int64          `json:"block_height"`
+	Chain              common.Chain   `json:"chain"`
+	TransactionSize    uint64         `json:"transaction_size"`
+	TransactionFeeRate uint64         `json:"transaction_fee_rate"`
+	Signer             sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgNetworkFee is a constructor function for MsgNetworkFee
+func NewMsgNetworkFee(blockHeight int64, chain common.Chain, transactionSize, transactionFeeRate uint64, signer sdk.AccAddress) MsgNetworkFee {
+	return MsgNetworkFee{
+		BlockHeight:        blockHeight,
+		Chain:              chain,
+		TransactionSize:    transactionSize,
+		TransactionFeeRate: transactionFeeRate,
+		Signer:             signer,
+	}
+}
+
+// Route should return the cmname of the module
+func (msg MsgNetworkFee) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgNetworkFee) Type() string { return "set_network_fee" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgNetworkFee) ValidateBasic() sdk.Error {
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+	if msg.BlockHeight <= 0 {
+		return sdk.ErrUnknownRequest("block height can't be zero or negative")
+	}
+	if err := NewNetworkFee(msg.Chain, msg.TransactionSize, msg.TransactionFeeRate).Valid(); err != nil {
+		return sdk.ErrUnknownRequest(err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgNetworkFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgNetworkFee) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type MsgNetworkFeeSuite struct{}
+
+var _ = Suite(&MsgNetworkFeeSuite{})
+
+func (MsgNetworkFeeSuite) TestMsgNetworkFee(c *C) {
+	acc1 := GetRandomBech32Addr()
+	msg := NewMsgNetworkFee(1024, common.BTCChain, 250, 10, acc1)
+	c.Assert(msg.Route(), Equals, RouterKey)
+	c.Assert(msg.Type(), Equals, "set_network_fee")
+	c.Assert(msg.ValidateBasic(), IsNil)
+	c.Assert(len(msg.GetSignBytes()) > 0, Equals, true)
+	c.Assert(msg.GetSigners(), NotNil)
+	c.Assert(msg.GetSigners()[0].String(), Equals, acc1.String())
+
+	inputs := []struct {
+		blockHeight        int64
+		chain              common.Chain
+		transactionSize    uint64
+		transactionFeeRate uint64
+		signer             sdk.AccAddress
+	}{
+		{0, common.BTCChain, 250, 10, acc1},
+		{1024, common.EmptyChain, 250, 10, acc1},
+		{1024, common.BTCChain, 0, 10, acc1},
+		{1024, common.BTCChain, 250, 0, acc1},
+		{1024, common.BTCChain, 250, 10, sdk.AccAddress{}},
+	}
+	for _, item := range inputs {
+		msg := NewMsgNetworkFee(item.blockHeight, item.chain, item.transactionSize, item.transactionFeeRate, item.signer)
+		c.Assert(msg.ValidateBasic(), NotNil)
+	}
+}
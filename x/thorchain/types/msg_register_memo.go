@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgRegisterMemo defines a MsgRegisterMemo message, it registers a memo that inbound txs can refer to by a short reference
+type MsgRegisterMemo struct {
+	Memo   string         `json:"memo"`
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgRegisterMemo is a constructor function for MsgRegisterMemo
+func NewMsgRegisterMemo(memo string, signer sdk.AccAddress) MsgRegisterMemo {
+	return MsgRegisterMemo{
+		Memo:   memo,
+		Signer: signer,
+	}
+}
+
+// Route should return the cmname of the module
+func (msg MsgRegisterMemo) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterMemo) Type() string { return "register_memo" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterMemo) ValidateBasic() sdk.Error {
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+	if len(msg.Memo) == 0 {
+		return sdk.ErrUnknownRequest("memo can't be empty")
+	}
+	if len([]byte(msg.Memo)) > MaxMemoRefSize {
+		err := fmt.Errorf("memo must not exceed %d bytes: %d", MaxMemoRefSize, len([]byte(msg.Memo)))
+		return sdk.ErrUnknownRequest(err.Error())
+	}
+	if _, ok := ParseMemoRef(msg.Memo); ok {
+		return sdk.ErrUnknownRequest("memo can't be a memo reference")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRegisterMemo) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterMemo) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
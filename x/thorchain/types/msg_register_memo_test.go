@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+)
+
+type MsgRegisterMemoSuite struct{}
+
+var _ = Suite(&MsgRegisterMemoSuite{})
+
+func (MsgRegisterMemoSuite) TestMsgRegisterMemo(c *C) {
+	acc1 := GetRandomBech32Addr()
+	msg := NewMsgRegisterMemo("swap:BNB.BNB:bnb1xlvns0n2mxh77mzaspn2hgav4rr4m8eerfju38", acc1)
+	c.Assert(msg.Route(), Equals, RouterKey)
+	c.Assert(msg.Type(), Equals, "register_memo")
+	c.Assert(msg.ValidateBasic(), IsNil)
+	c.Assert(len(msg.GetSignBytes()) > 0, Equals, true)
+	c.Assert(msg.GetSigners(), NotNil)
+	c.Assert(msg.GetSigners()[0].String(), Equals, acc1.String())
+
+	long := make([]byte, MaxMemoRefSize+1)
+	for i := range long {
+		long[i] = 'a'
+	}
+	inputs := []struct {
+		memo   string
+		signer sdk.AccAddress
+	}{
+		{"swap:BNB.BNB", sdk.AccAddress{}},
+		{"", acc1},
+		{string(long), acc1},
+		{"REF:12", acc1},
+	}
+	for _, item := range inputs {
+		msg := NewMsgRegisterMemo(item.memo, item.signer)
+		c.Assert(msg.ValidateBasic(), NotNil)
+	}
+}
@@ -1,27 +1,36 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gitlab.com/thorchain/thornode/common"
 )
 
+// MaxAffiliateFeeBasisPoints the maximum share (basis points) of a swap an affiliate can take
+const MaxAffiliateFeeBasisPoints = 1_000
+
 // MsgSwap defines a MsgSwap message
 type MsgSwap struct {
-	Tx          common.Tx      `json:"tx"`           // request tx
-	TargetAsset common.Asset   `json:"target_asset"` // target asset
-	Destination common.Address `json:"destination"`  // destination , used for swap and send , the destination address THORNode send it to
-	TradeTarget sdk.Uint       `json:"trade_target"`
-	Signer      sdk.AccAddress `json:"signer"`
+	Tx                   common.Tx      `json:"tx"`           // request tx
+	TargetAsset          common.Asset   `json:"target_asset"` // target asset
+	Destination          common.Address `json:"destination"`  // destination , used for swap and send , the destination address THORNode send it to
+	TradeTarget          sdk.Uint       `json:"trade_target"`
+	AffiliateAddress     common.Address `json:"affiliate_address"`      // RUNE or THORChain address the affiliate fee is paid to
+	AffiliateBasisPoints sdk.Uint       `json:"affiliate_basis_points"` // share of the swap input taken as affiliate fee
+	Signer               sdk.AccAddress `json:"signer"`
 }
 
 // NewMsgSwap is a constructor function for MsgSwap
-func NewMsgSwap(tx common.Tx, target common.Asset, destination common.Address, tradeTarget sdk.Uint, signer sdk.AccAddress) MsgSwap {
+func NewMsgSwap(tx common.Tx, target common.Asset, destination common.Address, tradeTarget sdk.Uint, affAddr common.Address, affPts sdk.Uint, signer sdk.AccAddress) MsgSwap {
 	return MsgSwap{
-		Tx:          tx,
-		TargetAsset: target,
-		Destination: destination,
-		TradeTarget: tradeTarget,
-		Signer:      signer,
+		Tx:                   tx,
+		TargetAsset:          target,
+		Destination:          destination,
+		TradeTarget:          tradeTarget,
+		AffiliateAddress:     affAddr,
+		AffiliateBasisPoints: affPts,
+		Signer:               signer,
 	}
 }
 
@@ -53,6 +62,17 @@ func (msg MsgSwap) ValidateBasic() sdk.Error {
 	if !msg.Destination.IsChain(msg.TargetAsset.Chain) {
 		return sdk.ErrUnknownRequest("swap destination and swap target asset must be the same chain")
 	}
+	if !msg.AffiliateAddress.IsEmpty() && !msg.AffiliateAddress.IsChain(common.RuneAsset().Chain) && !msg.AffiliateAddress.IsChain(common.THORChain) {
+		return sdk.ErrUnknownRequest("affiliate address must be a RUNE or THORChain address")
+	}
+	if !msg.AffiliateBasisPoints.IsZero() {
+		if msg.AffiliateAddress.IsEmpty() {
+			return sdk.ErrUnknownRequest("affiliate address can't be empty when there is an affiliate fee")
+		}
+		if msg.AffiliateBasisPoints.GT(sdk.NewUint(MaxAffiliateFeeBasisPoints)) {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("affiliate fee can't be more than %d basis points", MaxAffiliateFeeBasisPoints))
+		}
+	}
 	return nil
 }
 
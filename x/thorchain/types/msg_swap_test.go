@@ -28,10 +28,23 @@ func (MsgSwapSuite) TestMsgSwap(c *C) {
 		"SWAP:BNB.BNB",
 	)
 
-	m := NewMsgSwap(tx, common.BNBAsset, bnbAddress, sdk.NewUint(200000000), addr)
+	m := NewMsgSwap(tx, common.BNBAsset, bnbAddress, sdk.NewUint(200000000), common.NoAddress, sdk.ZeroUint(), addr)
 	EnsureMsgBasicCorrect(m, c)
 	c.Check(m.Type(), Equals, "swap")
 
+	// affiliate fee
+	runeAddr := GetRandomRUNEAddress()
+	m = NewMsgSwap(tx, common.BNBAsset, bnbAddress, sdk.ZeroUint(), runeAddr, sdk.NewUint(50), addr)
+	c.Check(m.ValidateBasic(), IsNil)
+	m = NewMsgSwap(tx, common.BNBAsset, bnbAddress, sdk.ZeroUint(), GetRandomTHORAddress(), sdk.NewUint(50), addr)
+	c.Check(m.ValidateBasic(), IsNil)
+	m = NewMsgSwap(tx, common.BNBAsset, bnbAddress, sdk.ZeroUint(), runeAddr, sdk.NewUint(MaxAffiliateFeeBasisPoints+1), addr)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgSwap(tx, common.BNBAsset, bnbAddress, sdk.ZeroUint(), common.NoAddress, sdk.NewUint(50), addr)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgSwap(tx, common.BNBAsset, bnbAddress, sdk.ZeroUint(), GetRandomBTCAddress(), sdk.NewUint(50), addr)
+	c.Check(m.ValidateBasic(), NotNil)
+
 	inputs := []struct {
 		requestTxHash common.TxID
 		source        common.Asset
@@ -135,7 +148,7 @@ func (MsgSwapSuite) TestMsgSwap(c *C) {
 			"SWAP:BNB.BNB",
 		)
 
-		m := NewMsgSwap(tx, item.target, item.destination, item.targetPrice, item.signer)
+		m := NewMsgSwap(tx, item.target, item.destination, item.targetPrice, common.NoAddress, sdk.ZeroUint(), item.signer)
 		c.Assert(m.ValidateBasic(), NotNil)
 	}
 }
@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// MsgUnbond when a node operator, or one of its bond providers, would like to withdraw some of their bond without leaving.
+// The node operator can also unbond the bond of one of its bond providers, which removes the bond provider once it has no bond left
+type MsgUnbond struct {
+	TxIn                common.Tx      `json:"tx_in"`
+	NodeAddress         sdk.AccAddress `json:"node_address"`
+	Amount              sdk.Uint       `json:"amount"`
+	BondAddress         common.Address `json:"bond_address"`
+	BondProviderAddress common.Address `json:"bond_provider_address"`
+	Signer              sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgUnbond create new MsgUnbond message
+func NewMsgUnbond(txin common.Tx, nodeAddr sdk.AccAddress, amount sdk.Uint, bondAddress, bondProviderAddress common.Address, signer sdk.AccAddress) MsgUnbond {
+	return MsgUnbond{
+		TxIn:                txin,
+		NodeAddress:         nodeAddr,
+		Amount:              amount,
+		BondAddress:         bondAddress,
+		BondProviderAddress: bondProviderAddress,
+		Signer:              signer,
+	}
+}
+
+// Route should return the router key of the module
+func (msg MsgUnbond) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgUnbond) Type() string { return "validator_unbond" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUnbond) ValidateBasic() sdk.Error {
+	// a bond provider that has no bond can be removed with a zero amount
+	if msg.Amount.IsZero() && msg.BondProviderAddress.IsEmpty() {
+		return sdk.ErrUnknownRequest("unbond amount cannot be zero")
+	}
+	if msg.BondAddress.IsEmpty() {
+		return sdk.ErrUnknownRequest("bond address cannot be empty")
+	}
+	if msg.TxIn.IsEmpty() {
+		return sdk.ErrUnknownRequest("request tx cannot be empty")
+	}
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress("empty signer address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUnbond) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUnbond) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
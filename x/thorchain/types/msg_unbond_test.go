@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type MsgUnbondSuite struct{}
+
+var _ = Suite(&MsgUnbondSuite{})
+
+func (mas *MsgUnbondSuite) SetUpSuite(c *C) {
+	SetupConfigForTest()
+}
+
+func (MsgUnbondSuite) TestMsgUnbond(c *C) {
+	nodeAddr := GetRandomBech32Addr()
+	signerAddr := GetRandomBech32Addr()
+	bondAddr := GetRandomBNBAddress()
+	txin := GetRandomTx()
+	txinNoID := txin
+	txinNoID.ID = ""
+	msg := NewMsgUnbond(txin, nodeAddr, sdk.NewUint(common.One), bondAddr, common.NoAddress, signerAddr)
+	EnsureMsgBasicCorrect(msg, c)
+	c.Assert(msg.ValidateBasic(), IsNil)
+	c.Assert(msg.Type(), Equals, "validator_unbond")
+	c.Assert(NewMsgUnbond(txin, sdk.AccAddress{}, sdk.NewUint(common.One), bondAddr, common.NoAddress, signerAddr).ValidateBasic(), IsNil)
+	c.Assert(NewMsgUnbond(txin, nodeAddr, sdk.ZeroUint(), bondAddr, common.NoAddress, signerAddr).ValidateBasic(), NotNil)
+	c.Assert(NewMsgUnbond(txin, nodeAddr, sdk.ZeroUint(), bondAddr, GetRandomBNBAddress(), signerAddr).ValidateBasic(), IsNil)
+	c.Assert(NewMsgUnbond(txinNoID, nodeAddr, sdk.NewUint(common.One), bondAddr, common.NoAddress, signerAddr).ValidateBasic(), NotNil)
+	c.Assert(NewMsgUnbond(txin, nodeAddr, sdk.NewUint(common.One), "", common.NoAddress, signerAddr).ValidateBasic(), NotNil)
+	c.Assert(NewMsgUnbond(txin, nodeAddr, sdk.NewUint(common.One), bondAddr, common.NoAddress, sdk.AccAddress{}).ValidateBasic(), NotNil)
+}
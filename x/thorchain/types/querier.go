@@ -21,6 +21,31 @@ func (n QueryResPools) String() string {
 	return strings.Join(assets, "\n")
 }
 
+// QueryPoolSnapshot is the depth and price of a pool at a block height
+type QueryPoolSnapshot struct {
+	Height       int64        `json:"height"`
+	Asset        common.Asset `json:"asset"`
+	Status       PoolStatus   `json:"status"`
+	BalanceRune  sdk.Uint     `json:"balance_rune"`
+	BalanceAsset sdk.Uint     `json:"balance_asset"`
+	PoolUnits    sdk.Uint     `json:"pool_units"`
+	// Price is the value of one asset (1e8) in RUNE (1e8)
+	Price sdk.Uint `json:"price"`
+}
+
+// NewQueryPoolSnapshot create a new snapshot of the given pool at the given height
+func NewQueryPoolSnapshot(height int64, pool Pool) QueryPoolSnapshot {
+	return QueryPoolSnapshot{
+		Height:       height,
+		Asset:        pool.Asset,
+		Status:       pool.Status,
+		BalanceRune:  pool.BalanceRune,
+		BalanceAsset: pool.BalanceAsset,
+		PoolUnits:    pool.PoolUnits,
+		Price:        pool.AssetValueInRune(sdk.NewUint(common.One)),
+	}
+}
+
 type QueryResHeights struct {
 	Chain            common.Chain `json:"chain"`
 	LastChainHeight  int64        `json:"lastobservedin"`
@@ -66,6 +91,10 @@ type QueryNodeAccount struct {
 	IPAddress           string           `json:"ip_address"`
 	Version             semver.Version   `json:"version"`
 	SlashPoints         int64            `json:"slash_points"`
+	BondProviders       BondProviders    `json:"bond_providers"`
+	OperatorFee         int64            `json:"operator_fee"`
+	Jailed              bool             `json:"jailed"`
+	JailReleaseHeight   int64            `json:"jail_release_height"`
 }
 
 func NewQueryNodeAccount(na NodeAccount) QueryNodeAccount {
@@ -84,5 +113,39 @@ func NewQueryNodeAccount(na NodeAccount) QueryNodeAccount {
 		LeaveHeight:         na.LeaveHeight,
 		IPAddress:           na.IPAddress,
 		Version:             na.Version,
+		BondProviders:       na.BondProviders,
+		OperatorFee:         na.OperatorFee,
+		JailReleaseHeight:   na.JailReleaseHeight,
 	}
 }
+
+// QueryResSlashes the slash points and slash history of a node account
+type QueryResSlashes struct {
+	NodeAddress sdk.AccAddress `json:"node_address"`
+	SlashPoints int64          `json:"slash_points"`
+	Records     SlashRecords   `json:"records"`
+}
+
+// QueryResObserverStats how well an observer observe each chain
+type QueryResObserverStats struct {
+	NodeAddress sdk.AccAddress       `json:"node_address"`
+	Chains      []QueryObserverChain `json:"chains"`
+}
+
+// QueryObserverChain the observation stats of an observer on one chain
+type QueryObserverChain struct {
+	Chain              common.Chain `json:"chain"`
+	ChainHeight        int64        `json:"chain_height"` // last block height of the chain observed by consensus
+	LastObservedHeight int64        `json:"last_observed_height"`
+	Observations       int64        `json:"observations"`
+	MissedConsensus    int64        `json:"missed_consensus"`
+	AverageDelay       int64        `json:"average_delay"`
+}
+
+// QueryResMigration the progress of moving funds out of a retiring asgard vault
+type QueryResMigration struct {
+	Plan            MigrationPlan `json:"plan"`
+	Remaining       common.Coins  `json:"remaining"`         // funds still held by the retiring vault
+	PendingTxs      int           `json:"pending_txs"`       // migration txs issued but not observed yet
+	NextRoundHeight int64         `json:"next_round_height"` // block height of the next round of migration txs
+}
@@ -1,8 +1,10 @@
 package types
 
 import (
-	"gitlab.com/thorchain/thornode/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
 )
 
 type QueryResPoolsSuite struct{}
@@ -16,3 +18,22 @@ func (QueryResPoolsSuite) TestQueryResPools(c *C) {
 	qrp = append(qrp, p)
 	c.Check(qrp.String(), Equals, "BNB.BNB")
 }
+
+func (QueryResPoolsSuite) TestQueryPoolSnapshot(c *C) {
+	p := NewPool()
+	p.Asset = common.BNBAsset
+	p.BalanceRune = sdk.NewUint(200 * common.One)
+	p.BalanceAsset = sdk.NewUint(100 * common.One)
+	p.PoolUnits = sdk.NewUint(100)
+	snapshot := NewQueryPoolSnapshot(12, p)
+	c.Check(snapshot.Height, Equals, int64(12))
+	c.Check(snapshot.Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(snapshot.BalanceRune.Equal(p.BalanceRune), Equals, true)
+	c.Check(snapshot.BalanceAsset.Equal(p.BalanceAsset), Equals, true)
+	c.Check(snapshot.PoolUnits.Equal(p.PoolUnits), Equals, true)
+	c.Check(snapshot.Price.Equal(sdk.NewUint(2*common.One)), Equals, true)
+
+	// an empty pool has no price
+	snapshot = NewQueryPoolSnapshot(12, NewPool())
+	c.Check(snapshot.Price.IsZero(), Equals, true)
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// MaxOperatorFeeBasisPoints is the max fee a node operator can charge their bond providers
+const MaxOperatorFeeBasisPoints = 10_000
+
+// BondProvider is an address, whitelisted by the node operator, that contributes bond to a node
+type BondProvider struct {
+	BondAddress common.Address `json:"bond_address"`
+	Bond        sdk.Uint       `json:"bond"`
+}
+
+// BondProviders a list of BondProvider
+type BondProviders []BondProvider
+
+// NewBondProvider create a new instance of BondProvider
+func NewBondProvider(addr common.Address, bond sdk.Uint) BondProvider {
+	return BondProvider{
+		BondAddress: addr,
+		Bond:        bond,
+	}
+}
+
+// IsEmpty check whether the bond provider is empty
+func (bp BondProvider) IsEmpty() bool {
+	return bp.BondAddress.IsEmpty()
+}
+
+// IsValid check whether bond provider has all necessary values
+func (bp BondProvider) IsValid() error {
+	if bp.BondAddress.IsEmpty() {
+		return errors.New("bond address cannot be empty")
+	}
+	return nil
+}
+
+// Has check whether the given address is whitelisted as a bond provider
+func (bps BondProviders) Has(addr common.Address) bool {
+	return !bps.Get(addr).IsEmpty()
+}
+
+// Get return the bond provider with the given address, it will return an empty BondProvider when it can't be found
+func (bps BondProviders) Get(addr common.Address) BondProvider {
+	for _, bp := range bps {
+		if bp.BondAddress.Equals(addr) {
+			return bp
+		}
+	}
+	return BondProvider{}
+}
+
+// TotalBond sum up the bond of all bond providers
+func (bps BondProviders) TotalBond() sdk.Uint {
+	total := sdk.ZeroUint()
+	for _, bp := range bps {
+		total = total.Add(bp.Bond)
+	}
+	return total
+}
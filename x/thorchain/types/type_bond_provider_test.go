@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type BondProviderSuite struct{}
+
+var _ = Suite(&BondProviderSuite{})
+
+func (BondProviderSuite) TestBondProviders(c *C) {
+	addr := GetRandomBNBAddress()
+	bp := NewBondProvider(addr, sdk.NewUint(common.One))
+	c.Check(bp.IsEmpty(), Equals, false)
+	c.Check(bp.IsValid(), IsNil)
+	c.Check(BondProvider{}.IsValid(), NotNil)
+
+	bps := BondProviders{bp, NewBondProvider(GetRandomBNBAddress(), sdk.NewUint(2*common.One))}
+	c.Check(bps.Has(addr), Equals, true)
+	c.Check(bps.Has(GetRandomBNBAddress()), Equals, false)
+	c.Check(bps.Get(addr).Bond.Equal(sdk.NewUint(common.One)), Equals, true)
+	c.Check(bps.TotalBond().Equal(sdk.NewUint(3*common.One)), Equals, true)
+
+	na := GetRandomNodeAccount(Active)
+	na.AddBondProvider(addr)
+	na.AddBondProvider(addr)
+	na.AddBondProvider(na.BondAddress)
+	c.Assert(na.BondProviders, HasLen, 1)
+	c.Check(na.AddProviderBond(addr, sdk.NewUint(common.One)), Equals, true)
+	c.Check(na.AddProviderBond(GetRandomBNBAddress(), sdk.NewUint(common.One)), Equals, false)
+	c.Check(na.OperatorBond().Equal(common.SafeSub(na.Bond, sdk.NewUint(common.One))), Equals, true)
+}
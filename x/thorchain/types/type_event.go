@@ -36,6 +36,7 @@ const (
 	ErrataEventType   = `errata`
 	FeeEventType      = `fee`
 	OutboundEventType = `outbound`
+	JailEventType     = `jail`
 )
 
 type PoolMod struct {
@@ -447,6 +448,7 @@ func (e EventReserve) Events() (sdk.Events, error) {
 type EventSlash struct {
 	Pool        common.Asset `json:"pool"`
 	SlashAmount []PoolAmt    `json:"slash_amount"`
+	Reason      string       `json:"reason,omitempty"`
 }
 
 func NewEventSlash(pool common.Asset, slashAmount []PoolAmt) EventSlash {
@@ -464,6 +466,9 @@ func (e EventSlash) Type() string {
 func (e EventSlash) Events() (sdk.Events, error) {
 	evt := sdk.NewEvent(e.Type(),
 		sdk.NewAttribute("pool", e.Pool.String()))
+	if e.Reason != "" {
+		evt = evt.AppendAttributes(sdk.NewAttribute("reason", e.Reason))
+	}
 	for _, item := range e.SlashAmount {
 		evt.AppendAttributes(sdk.NewAttribute(item.Asset.String(), strconv.FormatInt(item.Amount, 10)))
 	}
@@ -558,3 +563,33 @@ func (e EventOutbound) Events() (sdk.Events, error) {
 	evt = evt.AppendAttributes(e.Tx.ToAttributes()...)
 	return sdk.Events{evt}, nil
 }
+
+// EventJail represent a node account being put in jail
+type EventJail struct {
+	NodeAddress   sdk.AccAddress `json:"node_address"`
+	ReleaseHeight int64          `json:"release_height"`
+	Reason        string         `json:"reason"`
+}
+
+// NewEventJail create a new instance of EventJail
+func NewEventJail(addr sdk.AccAddress, releaseHeight int64, reason string) EventJail {
+	return EventJail{
+		NodeAddress:   addr,
+		ReleaseHeight: releaseHeight,
+		Reason:        reason,
+	}
+}
+
+// Type return jail event type
+func (e EventJail) Type() string {
+	return JailEventType
+}
+
+// Events return sdk events
+func (e EventJail) Events() (sdk.Events, error) {
+	evt := sdk.NewEvent(e.Type(),
+		sdk.NewAttribute("node_address", e.NodeAddress.String()),
+		sdk.NewAttribute("release_height", strconv.FormatInt(e.ReleaseHeight, 10)),
+		sdk.NewAttribute("reason", e.Reason))
+	return sdk.Events{evt}, nil
+}
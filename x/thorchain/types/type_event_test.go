@@ -148,6 +148,29 @@ func (s EventSuite) TestSlash(c *C) {
 	c.Check(evt.SlashAmount[0].Amount, Equals, int64(-20))
 	c.Check(evt.SlashAmount[1].Asset, Equals, common.RuneAsset())
 	c.Check(evt.SlashAmount[1].Amount, Equals, int64(30))
+	evt.Reason = string(SlashReasonDoubleSign)
+	events, err := evt.Events()
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 1)
+	found := false
+	for _, attr := range events[0].Attributes {
+		if string(attr.Key) == "reason" && string(attr.Value) == evt.Reason {
+			found = true
+		}
+	}
+	c.Check(found, Equals, true)
+}
+
+func (s EventSuite) TestJail(c *C) {
+	addr := GetRandomBech32Addr()
+	evt := NewEventJail(addr, 100, "fail keysign")
+	c.Check(evt.Type(), Equals, "jail")
+	c.Check(evt.NodeAddress.Equals(addr), Equals, true)
+	c.Check(evt.ReleaseHeight, Equals, int64(100))
+	events, err := evt.Events()
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 1)
+	c.Check(events[0].Type, Equals, JailEventType)
 }
 
 func (s EventSuite) TestEventGas(c *C) {
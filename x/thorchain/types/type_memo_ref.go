@@ -0,0 +1,74 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MemoRefPrefix the prefix of a memo reference, an inbound tx can use REF:<id> as its memo instead of the full memo
+const MemoRefPrefix = "REF:"
+
+// MaxMemoRefSize the maximum size (in bytes) of a memo that can be registered
+const MaxMemoRefSize = 512
+
+// MemoRef a memo registered on THORChain, so inbound txs on chains with little memo space can refer to it by a short reference
+type MemoRef struct {
+	ID          int64          `json:"id"`
+	Memo        string         `json:"memo"`
+	Owner       sdk.AccAddress `json:"owner"`
+	BlockHeight int64          `json:"block_height"` // THORChain block height the memo was registered at
+}
+
+// NewMemoRef create a new instance of MemoRef
+func NewMemoRef(id int64, memo string, owner sdk.AccAddress, height int64) MemoRef {
+	return MemoRef{
+		ID:          id,
+		Memo:        memo,
+		Owner:       owner,
+		BlockHeight: height,
+	}
+}
+
+// IsEmpty return true when the memo reference doesn't exist
+func (m MemoRef) IsEmpty() bool {
+	return m.ID == 0 || len(m.Memo) == 0
+}
+
+// Valid return an error when the memo reference is not valid
+func (m MemoRef) Valid() error {
+	if m.ID <= 0 {
+		return errors.New("id must be positive")
+	}
+	if len(m.Memo) == 0 {
+		return errors.New("memo can't be empty")
+	}
+	if len([]byte(m.Memo)) > MaxMemoRefSize {
+		return fmt.Errorf("memo must not exceed %d bytes", MaxMemoRefSize)
+	}
+	if m.Owner.Empty() {
+		return errors.New("owner can't be empty")
+	}
+	return nil
+}
+
+// Reference return the short memo an inbound tx use to refer to the registered memo
+func (m MemoRef) Reference() string {
+	return fmt.Sprintf("%s%d", MemoRefPrefix, m.ID)
+}
+
+// ParseMemoRef return the id of the memo reference, false will be returned when the memo is not a memo reference
+func ParseMemoRef(memo string) (int64, bool) {
+	memo = strings.TrimSpace(memo)
+	if len(memo) <= len(MemoRefPrefix) || !strings.EqualFold(memo[:len(MemoRefPrefix)], MemoRefPrefix) {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(memo[len(MemoRefPrefix):], 10, 64)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type MemoRefSuite struct{}
+
+var _ = Suite(&MemoRefSuite{})
+
+func (MemoRefSuite) TestMemoRef(c *C) {
+	owner := GetRandomBech32Addr()
+	ref := NewMemoRef(12, "swap:BNB.BNB:bnb1xlvns0n2mxh77mzaspn2hgav4rr4m8eerfju38", owner, 100)
+	c.Check(ref.Valid(), IsNil)
+	c.Check(ref.IsEmpty(), Equals, false)
+	c.Check(ref.Reference(), Equals, "REF:12")
+	c.Check(MemoRef{}.IsEmpty(), Equals, true)
+
+	c.Check(NewMemoRef(0, "swap:BNB.BNB", owner, 100).Valid(), NotNil)
+	c.Check(NewMemoRef(12, "", owner, 100).Valid(), NotNil)
+	c.Check(NewMemoRef(12, "swap:BNB.BNB", nil, 100).Valid(), NotNil)
+	long := make([]byte, MaxMemoRefSize+1)
+	for i := range long {
+		long[i] = 'a'
+	}
+	c.Check(NewMemoRef(12, string(long), owner, 100).Valid(), NotNil)
+}
+
+func (MemoRefSuite) TestParseMemoRef(c *C) {
+	id, ok := ParseMemoRef("REF:12")
+	c.Check(ok, Equals, true)
+	c.Check(id, Equals, int64(12))
+	id, ok = ParseMemoRef("ref:7")
+	c.Check(ok, Equals, true)
+	c.Check(id, Equals, int64(7))
+
+	for _, memo := range []string{"", "REF:", "REF:abc", "REF:-1", "REF:0", "swap:BNB.BNB", "REFUND:12"} {
+		_, ok := ParseMemoRef(memo)
+		c.Check(ok, Equals, false, Commentf("%s", memo))
+	}
+}
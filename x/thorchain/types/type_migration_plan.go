@@ -0,0 +1,96 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// MigrationItem the progress of moving one asset out of a retiring vault
+type MigrationItem struct {
+	Asset       common.Asset `json:"asset"`
+	Amount      sdk.Uint     `json:"amount"`   // amount held by the retiring vault when the migration started
+	Migrated    sdk.Uint     `json:"migrated"` // amount observed leaving the retiring vault
+	TxIssued    int64        `json:"tx_issued"`
+	TxConfirmed int64        `json:"tx_confirmed"`
+}
+
+// MigrationPlan track the migration of funds from a retiring asgard vault to the active asgard vaults
+type MigrationPlan struct {
+	VaultPubKey common.PubKey   `json:"vault_pub_key"`
+	StartHeight int64           `json:"start_height"` // height the vault started retiring
+	Items       []MigrationItem `json:"items"`
+	GasSpent    common.Gas      `json:"gas_spent"`
+}
+
+// NewMigrationPlan create a new instance of MigrationPlan to move all the coins of the given vault,
+// height is the height the vault started retiring
+func NewMigrationPlan(height int64, vault Vault) MigrationPlan {
+	plan := MigrationPlan{
+		VaultPubKey: vault.PubKey,
+		StartHeight: height,
+	}
+	for _, coin := range vault.Coins {
+		if coin.IsEmpty() {
+			continue
+		}
+		plan.Items = append(plan.Items, newMigrationItem(coin))
+	}
+	return plan
+}
+
+func newMigrationItem(coin common.Coin) MigrationItem {
+	return MigrationItem{
+		Asset:    coin.Asset,
+		Amount:   coin.Amount,
+		Migrated: sdk.ZeroUint(),
+	}
+}
+
+// IsEmpty return true when the migration plan doesn't belong to any vault
+func (p MigrationPlan) IsEmpty() bool {
+	return p.VaultPubKey.IsEmpty()
+}
+
+// GetItem return the migration item of the given asset, an empty item will be returned when the asset is not part of the plan
+func (p MigrationPlan) GetItem(asset common.Asset) MigrationItem {
+	for _, item := range p.Items {
+		if item.Asset.Equals(asset) {
+			return item
+		}
+	}
+	return MigrationItem{Asset: asset, Amount: sdk.ZeroUint(), Migrated: sdk.ZeroUint()}
+}
+
+func (p *MigrationPlan) getItemIndex(asset common.Asset) int {
+	for i, item := range p.Items {
+		if item.Asset.Equals(asset) {
+			return i
+		}
+	}
+	return -1
+}
+
+// AddTxIssued record a migration tx issued for the given coin of the retiring vault
+// coins the retiring vault received after the migration started are added to the plan
+func (p *MigrationPlan) AddTxIssued(coin common.Coin) {
+	idx := p.getItemIndex(coin.Asset)
+	if idx < 0 {
+		p.Items = append(p.Items, newMigrationItem(coin))
+		idx = len(p.Items) - 1
+	}
+	p.Items[idx].TxIssued++
+}
+
+// AddTxConfirmed record a migration tx observed leaving the retiring vault
+func (p *MigrationPlan) AddTxConfirmed(coins common.Coins, gas common.Gas) {
+	for _, coin := range coins {
+		idx := p.getItemIndex(coin.Asset)
+		if idx < 0 {
+			continue
+		}
+		p.Items[idx].Migrated = p.Items[idx].Migrated.Add(coin.Amount)
+		p.Items[idx].TxConfirmed++
+	}
+	p.GasSpent = p.GasSpent.Add(gas)
+}
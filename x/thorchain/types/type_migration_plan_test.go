@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type MigrationPlanSuite struct{}
+
+var _ = Suite(&MigrationPlanSuite{})
+
+func (s *MigrationPlanSuite) TestMigrationPlan(c *C) {
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(100*common.One)),
+		common.NewCoin(common.RuneAsset(), sdk.NewUint(1000*common.One)),
+		common.NewCoin(common.BTCAsset, sdk.ZeroUint()),
+	}
+	plan := NewMigrationPlan(10, vault)
+	c.Check(plan.IsEmpty(), Equals, false)
+	c.Check(plan.StartHeight, Equals, int64(10))
+	c.Assert(plan.Items, HasLen, 2)
+	c.Check(MigrationPlan{}.IsEmpty(), Equals, true)
+
+	plan.AddTxIssued(common.NewCoin(common.BNBAsset, sdk.NewUint(20*common.One)))
+	plan.AddTxIssued(common.NewCoin(common.BTCAsset, sdk.NewUint(common.One)))
+	c.Assert(plan.Items, HasLen, 3)
+	c.Check(plan.GetItem(common.BNBAsset).TxIssued, Equals, int64(1))
+	c.Check(plan.GetItem(common.BTCAsset).Amount.Equal(sdk.NewUint(common.One)), Equals, true)
+
+	plan.AddTxConfirmed(common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(20*common.One))}, common.BNBGasFeeSingleton)
+	plan.AddTxConfirmed(common.Coins{common.NewCoin(common.BNBAsset, sdk.NewUint(30*common.One))}, common.BNBGasFeeSingleton)
+	bnb := plan.GetItem(common.BNBAsset)
+	c.Check(bnb.TxConfirmed, Equals, int64(2))
+	c.Check(bnb.Migrated.Equal(sdk.NewUint(50*common.One)), Equals, true)
+	c.Check(bnb.Amount.Equal(sdk.NewUint(100*common.One)), Equals, true)
+	c.Check(plan.GasSpent.ToCoins().GetCoin(common.BNBAsset).Amount.Equal(common.BNBGasFeeSingleton[0].Amount.MulUint64(2)), Equals, true)
+
+	item := plan.GetItem(common.ETHAsset)
+	c.Check(item.TxIssued, Equals, int64(0))
+	c.Check(item.Amount.IsZero(), Equals, true)
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// NetworkFee the fee rate of a chain agreed by the active node accounts, and the size of a typical outbound tx on that chain
+type NetworkFee struct {
+	Chain              common.Chain `json:"chain"`
+	TransactionSize    uint64       `json:"transaction_size"`     // in bytes (vbytes for BTC)
+	TransactionFeeRate uint64       `json:"transaction_fee_rate"` // in the smallest unit of the gas asset per byte
+}
+
+// NewNetworkFee create a new instance of NetworkFee
+func NewNetworkFee(chain common.Chain, transactionSize, transactionFeeRate uint64) NetworkFee {
+	return NetworkFee{
+		Chain:              chain,
+		TransactionSize:    transactionSize,
+		TransactionFeeRate: transactionFeeRate,
+	}
+}
+
+// IsEmpty return true when the network fee of the chain has not been agreed yet
+func (f NetworkFee) IsEmpty() bool {
+	return f.Chain.IsEmpty() || f.TransactionSize == 0 || f.TransactionFeeRate == 0
+}
+
+// Valid return an error when the network fee is not valid
+func (f NetworkFee) Valid() error {
+	if f.Chain.IsEmpty() {
+		return errors.New("chain can't be empty")
+	}
+	if f.TransactionSize == 0 {
+		return errors.New("transaction size can't be zero")
+	}
+	if f.TransactionFeeRate == 0 {
+		return errors.New("transaction fee rate can't be zero")
+	}
+	return nil
+}
+
+// GetGas return the gas a typical outbound tx on the chain should pay
+func (f NetworkFee) GetGas() common.Gas {
+	return common.Gas{
+		common.NewCoin(f.Chain.GetGasAsset(), sdk.NewUint(f.TransactionSize*f.TransactionFeeRate)),
+	}
+}
+
+// String implement fmt.Stringer
+func (f NetworkFee) String() string {
+	return fmt.Sprintf("chain: %s, transaction size: %d, fee rate: %d", f.Chain, f.TransactionSize, f.TransactionFeeRate)
+}
+
+// NetworkFeeReport the network fee of a chain reported by a node account
+type NetworkFeeReport struct {
+	NodeAddress        sdk.AccAddress `json:"node_address"`
+	BlockHeight        int64          `json:"block_height"`  // block height on the reported chain
+	ReportHeight       int64          `json:"report_height"` // THORChain block height the report was made at
+	Chain              common.Chain   `json:"chain"`
+	TransactionSize    uint64         `json:"transaction_size"`
+	TransactionFeeRate uint64         `json:"transaction_fee_rate"`
+}
+
+// NetworkFeeReports a list of NetworkFeeReport
+type NetworkFeeReports []NetworkFeeReport
+
+// Median return the network fee made of the median transaction size and the median fee rate of the reports
+func (rs NetworkFeeReports) Median(chain common.Chain) NetworkFee {
+	if len(rs) == 0 {
+		return NetworkFee{Chain: chain}
+	}
+	sizes := make([]uint64, len(rs))
+	rates := make([]uint64, len(rs))
+	for i, item := range rs {
+		sizes[i] = item.TransactionSize
+		rates[i] = item.TransactionFeeRate
+	}
+	return NewNetworkFee(chain, medianUint64(sizes), medianUint64(rates))
+}
+
+// IsExpired return true when the report was made more than expiry blocks before the given THORChain block height
+func (r NetworkFeeReport) IsExpired(height, expiry int64) bool {
+	return r.ReportHeight+expiry < height
+}
+
+// Fresh return the reports made within expiry blocks of the given THORChain block height
+func (rs NetworkFeeReports) Fresh(height, expiry int64) NetworkFeeReports {
+	var reports NetworkFeeReports
+	for _, item := range rs {
+		if !item.IsExpired(height, expiry) {
+			reports = append(reports, item)
+		}
+	}
+	return reports
+}
+
+func medianUint64(values []uint64) uint64 {
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
+}
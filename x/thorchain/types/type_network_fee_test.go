@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type NetworkFeeSuite struct{}
+
+var _ = Suite(&NetworkFeeSuite{})
+
+func (NetworkFeeSuite) TestNetworkFee(c *C) {
+	fee := NewNetworkFee(common.BTCChain, 250, 10)
+	c.Check(fee.Valid(), IsNil)
+	c.Check(fee.IsEmpty(), Equals, false)
+	gas := fee.GetGas()
+	c.Assert(gas, HasLen, 1)
+	c.Check(gas[0].Asset.Equals(common.BTCAsset), Equals, true)
+	c.Check(gas[0].Amount.Equal(sdk.NewUint(2500)), Equals, true)
+
+	c.Check(NewNetworkFee(common.EmptyChain, 250, 10).Valid(), NotNil)
+	c.Check(NewNetworkFee(common.BTCChain, 0, 10).Valid(), NotNil)
+	c.Check(NewNetworkFee(common.BTCChain, 250, 0).Valid(), NotNil)
+	c.Check(NetworkFee{}.IsEmpty(), Equals, true)
+}
+
+func (NetworkFeeSuite) TestNetworkFeeReportsMedian(c *C) {
+	var reports NetworkFeeReports
+	c.Check(reports.Median(common.BTCChain).IsEmpty(), Equals, true)
+
+	reports = NetworkFeeReports{
+		{NodeAddress: GetRandomBech32Addr(), Chain: common.BTCChain, TransactionSize: 250, TransactionFeeRate: 30},
+		{NodeAddress: GetRandomBech32Addr(), Chain: common.BTCChain, TransactionSize: 200, TransactionFeeRate: 10},
+		{NodeAddress: GetRandomBech32Addr(), Chain: common.BTCChain, TransactionSize: 250, TransactionFeeRate: 1000},
+	}
+	fee := reports.Median(common.BTCChain)
+	c.Check(fee.TransactionSize, Equals, uint64(250))
+	c.Check(fee.TransactionFeeRate, Equals, uint64(30))
+
+	reports = append(reports, NetworkFeeReport{NodeAddress: GetRandomBech32Addr(), Chain: common.BTCChain, TransactionSize: 250, TransactionFeeRate: 20})
+	fee = reports.Median(common.BTCChain)
+	c.Check(fee.TransactionSize, Equals, uint64(250))
+	c.Check(fee.TransactionFeeRate, Equals, uint64(25))
+
+	reports = NetworkFeeReports{
+		{NodeAddress: GetRandomBech32Addr(), ReportHeight: 101, Chain: common.BTCChain, TransactionSize: 250, TransactionFeeRate: 30},
+		{NodeAddress: GetRandomBech32Addr(), ReportHeight: 100, Chain: common.BTCChain, TransactionSize: 250, TransactionFeeRate: 10},
+	}
+	c.Check(reports.Fresh(150, 50), HasLen, 2)
+	c.Check(reports.Fresh(151, 50), HasLen, 1)
+	c.Check(reports.Fresh(151, 50)[0].ReportHeight, Equals, int64(101))
+	c.Check(reports[1].IsExpired(150, 50), Equals, false)
+	c.Check(reports[1].IsExpired(151, 50), Equals, true)
+}
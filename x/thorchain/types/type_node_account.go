@@ -99,6 +99,9 @@ type NodeAccount struct {
 	LeaveHeight         int64            `json:"leave_height"`
 	IPAddress           string           `json:"ip_address"`
 	Version             semver.Version   `json:"version"`
+	BondProviders       BondProviders    `json:"bond_providers"`
+	OperatorFee         int64            `json:"operator_fee"` // basis points of the bond providers' rewards paid to the node operator
+	JailReleaseHeight   int64            `json:"jail_release_height"`
 }
 
 // NewNodeAccount create new instance of NodeAccount
@@ -134,6 +137,18 @@ func (n NodeAccount) IsValid() error {
 	return nil
 }
 
+// IsJailed check whether the node account is still in jail at the given block height
+func (n NodeAccount) IsJailed(height int64) bool {
+	return n.JailReleaseHeight > height
+}
+
+// Jail put the node account in jail until the given release height, it never shortens the jail time
+func (n *NodeAccount) Jail(releaseHeight int64) {
+	if releaseHeight > n.JailReleaseHeight {
+		n.JailReleaseHeight = releaseHeight
+	}
+}
+
 // UpdateStatus change the status of node account, in the mean time update StatusSince field
 func (n *NodeAccount) UpdateStatus(status NodeStatus, height int64) {
 	if n.Status == status {
@@ -167,6 +182,8 @@ func (n NodeAccount) String() string {
 	sb.WriteString("version:" + n.Version.String() + "\n")
 	sb.WriteString("bond address:" + n.BondAddress.String() + "\n")
 	sb.WriteString("requested to leave:" + strconv.FormatBool(n.RequestedToLeave) + "\n")
+	sb.WriteString("bond providers:" + strconv.Itoa(len(n.BondProviders)) + "\n")
+	sb.WriteString("jail release height:" + strconv.FormatInt(n.JailReleaseHeight, 10) + "\n")
 	return sb.String()
 }
 
@@ -190,12 +207,101 @@ func (n *NodeAccount) AddBond(amt sdk.Uint) {
 	n.Bond = n.Bond.Add(amt)
 }
 
+// SubBond take the given amount out of the node bond, bond providers will be
+// reduced pro-rata to their share of the bond
 func (n *NodeAccount) SubBond(amt sdk.Uint) {
+	oldBond := n.Bond
 	if n.Bond.LT(amt) {
 		n.Bond = sdk.ZeroUint()
 	} else {
 		n.Bond = common.SafeSub(n.Bond, amt)
 	}
+	for i, bp := range n.BondProviders {
+		if oldBond.IsZero() {
+			n.BondProviders[i].Bond = sdk.ZeroUint()
+			continue
+		}
+		n.BondProviders[i].Bond = bp.Bond.Mul(n.Bond).Quo(oldBond)
+	}
+}
+
+// OperatorBond return the portion of the bond that belongs to the node operator
+func (n NodeAccount) OperatorBond() sdk.Uint {
+	return common.SafeSub(n.Bond, n.BondProviders.TotalBond())
+}
+
+// AddBondProvider whitelist the given address as a bond provider of the node
+func (n *NodeAccount) AddBondProvider(addr common.Address) {
+	if addr.IsEmpty() || addr.Equals(n.BondAddress) || n.BondProviders.Has(addr) {
+		return
+	}
+	n.BondProviders = append(n.BondProviders, NewBondProvider(addr, sdk.ZeroUint()))
+}
+
+// RemoveBondProvider remove the given address from the bond providers of the node
+func (n *NodeAccount) RemoveBondProvider(addr common.Address) {
+	for i, bp := range n.BondProviders {
+		if bp.BondAddress.Equals(addr) {
+			n.BondProviders = append(n.BondProviders[:i], n.BondProviders[i+1:]...)
+			return
+		}
+	}
+}
+
+// AddProviderBond add the given amount to the bond of a whitelisted bond
+// provider, and the node bond. It returns false when the address is not a bond
+// provider of the node
+func (n *NodeAccount) AddProviderBond(addr common.Address, amt sdk.Uint) bool {
+	for i, bp := range n.BondProviders {
+		if bp.BondAddress.Equals(addr) {
+			n.BondProviders[i].Bond = bp.Bond.Add(amt)
+			n.Bond = n.Bond.Add(amt)
+			return true
+		}
+	}
+	return false
+}
+
+// BondOf return the portion of the bond that belongs to the given bond
+// address, which is either the node operator or one of its bond providers
+func (n NodeAccount) BondOf(addr common.Address) sdk.Uint {
+	if addr.Equals(n.BondAddress) {
+		return n.OperatorBond()
+	}
+	bp := n.BondProviders.Get(addr)
+	if bp.IsEmpty() {
+		return sdk.ZeroUint()
+	}
+	return bp.Bond
+}
+
+// UnbondFrom take the given amount out of the bond that belongs to the given
+// bond address. It returns false when the address doesn't have enough bond
+func (n *NodeAccount) UnbondFrom(addr common.Address, amt sdk.Uint) bool {
+	if n.BondOf(addr).LT(amt) {
+		return false
+	}
+	for i, bp := range n.BondProviders {
+		if bp.BondAddress.Equals(addr) {
+			n.BondProviders[i].Bond = common.SafeSub(bp.Bond, amt)
+		}
+	}
+	n.Bond = common.SafeSub(n.Bond, amt)
+	return true
+}
+
+// AddBondReward add the given reward to the node bond. Each bond provider
+// receives a share of the reward pro-rata to their bond, less the operator
+// fee, which stays with the node operator
+func (n *NodeAccount) AddBondReward(reward sdk.Uint) {
+	if !n.Bond.IsZero() {
+		for i, bp := range n.BondProviders {
+			share := bp.Bond.Mul(reward).Quo(n.Bond)
+			fee := share.MulUint64(uint64(n.OperatorFee)).QuoUint64(MaxOperatorFeeBasisPoints)
+			n.BondProviders[i].Bond = bp.Bond.Add(common.SafeSub(share, fee))
+		}
+	}
+	n.Bond = n.Bond.Add(reward)
 }
 
 // AddSignerPubKey add a key to node account
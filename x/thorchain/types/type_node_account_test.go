@@ -179,3 +179,17 @@ func (s *NodeAccountSuite) TestCalcNodeRewards(c *C) {
 	blocks = na.CalcBondUnits(50, 0)
 	c.Check(blocks.Uint64(), Equals, uint64(0), Commentf("%d", blocks.Uint64()))
 }
+
+func (NodeAccountSuite) TestJail(c *C) {
+	na := GetRandomNodeAccount(Active)
+	c.Check(na.IsJailed(10), Equals, false)
+	na.Jail(100)
+	c.Check(na.IsJailed(10), Equals, true)
+	c.Check(na.IsJailed(99), Equals, true)
+	c.Check(na.IsJailed(100), Equals, false)
+	// jail time can't be shortened
+	na.Jail(50)
+	c.Check(na.JailReleaseHeight, Equals, int64(100))
+	na.Jail(200)
+	c.Check(na.JailReleaseHeight, Equals, int64(200))
+}
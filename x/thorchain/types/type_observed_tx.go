@@ -86,6 +86,18 @@ func (tx ObservedTx) HasSigned(signer sdk.AccAddress) bool {
 	return false
 }
 
+// HasThreshold check whether the observed tx has been signed by the given
+// threshold (basis points) of node accounts, see HasThreshold
+func (tx ObservedTx) HasThreshold(nodeAccounts NodeAccounts, threshold int64) bool {
+	var count int
+	for _, signer := range tx.Signers {
+		if nodeAccounts.IsNodeKeys(signer) {
+			count += 1
+		}
+	}
+	return HasThreshold(count, len(nodeAccounts), threshold)
+}
+
 func (tx *ObservedTx) Sign(signer sdk.AccAddress) {
 	if !tx.HasSigned(signer) {
 		tx.Signers = append(tx.Signers, signer)
@@ -120,6 +132,8 @@ type ObservedTxVoter struct {
 	Txs          ObservedTxs `json:"in_tx"`         // copies of tx in by various observers.
 	Actions      []TxOutItem `json:"actions"`       // outbound txs set to be sent
 	OutTxs       common.Txs  `json:"out_txs"`       // observed outbound transactions
+	// thorchain block height the tx had been observed by the first observer
+	FirstObservedHeight int64 `json:"first_observed_height"`
 }
 
 type ObservedTxVoters []ObservedTxVoter
@@ -193,6 +207,16 @@ func (tx *ObservedTxVoter) IsDone() bool {
 	return len(tx.Actions) <= len(tx.OutTxs)
 }
 
+// HasSigned check whether the given signer has observed the tx already
+func (tx ObservedTxVoter) HasSigned(signer sdk.AccAddress) bool {
+	for _, transaction := range tx.Txs {
+		if transaction.HasSigned(signer) {
+			return true
+		}
+	}
+	return false
+}
+
 func (tx *ObservedTxVoter) Add(observedTx ObservedTx, signer sdk.AccAddress) {
 	// check if this signer has already signed, no take backs allowed
 	for _, transaction := range tx.Txs {
@@ -215,14 +239,14 @@ func (tx *ObservedTxVoter) Add(observedTx ObservedTx, signer sdk.AccAddress) {
 }
 
 func (tx ObservedTxVoter) HasConsensus(nodeAccounts NodeAccounts) bool {
+	return tx.HasConsensusThreshold(nodeAccounts, 0)
+}
+
+// HasConsensusThreshold check whether any of the observed txs has been signed
+// by the given threshold (basis points) of node accounts, see HasThreshold
+func (tx ObservedTxVoter) HasConsensusThreshold(nodeAccounts NodeAccounts, threshold int64) bool {
 	for _, txIn := range tx.Txs {
-		var count int
-		for _, signer := range txIn.Signers {
-			if nodeAccounts.IsNodeKeys(signer) {
-				count += 1
-			}
-		}
-		if HasSuperMajority(count, len(nodeAccounts)) {
+		if txIn.HasThreshold(nodeAccounts, threshold) {
 			return true
 		}
 	}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// ObserverChainStats track how well a node account observe a chain
+type ObserverChainStats struct {
+	Chain              common.Chain `json:"chain"`
+	LastObservedHeight int64        `json:"last_observed_height"` // block height on the observed chain
+	Observations       int64        `json:"observations"`
+	MissedConsensus    int64        `json:"missed_consensus"` // observations that arrived after the tx reached consensus
+	TotalDelay         int64        `json:"total_delay"`      // total blocks behind the first observer of each tx
+}
+
+// AverageDelay return the average number of blocks the node observe a tx behind its first observer
+func (s ObserverChainStats) AverageDelay() int64 {
+	if s.Observations == 0 {
+		return 0
+	}
+	return s.TotalDelay / s.Observations
+}
+
+// ObserverStats the observation stats of a node account across all chains
+type ObserverStats struct {
+	NodeAddress sdk.AccAddress       `json:"node_address"`
+	Chains      []ObserverChainStats `json:"chains"`
+}
+
+// NewObserverStats create a new instance of ObserverStats
+func NewObserverStats(addr sdk.AccAddress) ObserverStats {
+	return ObserverStats{
+		NodeAddress: addr,
+	}
+}
+
+// GetChainStats return the stats of the given chain, empty stats will be returned when the node hasn't observed the chain yet
+func (s ObserverStats) GetChainStats(chain common.Chain) ObserverChainStats {
+	for _, item := range s.Chains {
+		if item.Chain.Equals(chain) {
+			return item
+		}
+	}
+	return ObserverChainStats{Chain: chain}
+}
+
+// AddObservation record an observation of a tx on the given chain
+// height is the block height of the tx on the observed chain
+// delay is the number of blocks the node is behind the first observer of the tx
+func (s *ObserverStats) AddObservation(chain common.Chain, height, delay int64, missedConsensus bool) {
+	stats := s.GetChainStats(chain)
+	if height > stats.LastObservedHeight {
+		stats.LastObservedHeight = height
+	}
+	stats.Observations++
+	if missedConsensus {
+		stats.MissedConsensus++
+	}
+	if delay > 0 {
+		stats.TotalDelay += delay
+	}
+	for i, item := range s.Chains {
+		if item.Chain.Equals(chain) {
+			s.Chains[i] = stats
+			return
+		}
+	}
+	s.Chains = append(s.Chains, stats)
+}
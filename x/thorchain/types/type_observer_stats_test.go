@@ -0,0 +1,33 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type ObserverStatsSuite struct{}
+
+var _ = Suite(&ObserverStatsSuite{})
+
+func (s *ObserverStatsSuite) TestObserverStats(c *C) {
+	stats := NewObserverStats(GetRandomBech32Addr())
+	c.Check(stats.GetChainStats(common.BNBChain).Observations, Equals, int64(0))
+	c.Check(stats.GetChainStats(common.BNBChain).AverageDelay(), Equals, int64(0))
+
+	stats.AddObservation(common.BNBChain, 100, 0, false)
+	stats.AddObservation(common.BNBChain, 90, 4, true)
+	stats.AddObservation(common.BTCChain, 10, 1, false)
+	c.Assert(stats.Chains, HasLen, 2)
+
+	bnb := stats.GetChainStats(common.BNBChain)
+	c.Check(bnb.LastObservedHeight, Equals, int64(100))
+	c.Check(bnb.Observations, Equals, int64(2))
+	c.Check(bnb.MissedConsensus, Equals, int64(1))
+	c.Check(bnb.AverageDelay(), Equals, int64(2))
+
+	btc := stats.GetChainStats(common.BTCChain)
+	c.Check(btc.LastObservedHeight, Equals, int64(10))
+	c.Check(btc.Observations, Equals, int64(1))
+	c.Check(btc.MissedConsensus, Equals, int64(0))
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// PendingLiquidity the first leg of a stake made from two chains, it waits for the other leg to arrive
+// before both are staked together
+type PendingLiquidity struct {
+	Asset             common.Asset   `json:"asset"`
+	RuneAddress       common.Address `json:"rune_address"`
+	AssetAddress      common.Address `json:"asset_address"`
+	PendingRune       sdk.Uint       `json:"pending_rune"`
+	PendingAsset      sdk.Uint       `json:"pending_asset"`
+	Txs               common.Txs     `json:"txs"`                 // the inbound txs that make up the pending side
+	ExpireBlockHeight int64          `json:"expire_block_height"` // THORChain block height the pending side is committed or refunded at
+}
+
+// NewPendingLiquidity create a new instance of PendingLiquidity
+func NewPendingLiquidity(asset common.Asset, runeAddr, assetAddr common.Address) PendingLiquidity {
+	return PendingLiquidity{
+		Asset:        asset,
+		RuneAddress:  runeAddr,
+		AssetAddress: assetAddr,
+		PendingRune:  sdk.ZeroUint(),
+		PendingAsset: sdk.ZeroUint(),
+	}
+}
+
+// IsEmpty return true when nothing is pending
+func (p PendingLiquidity) IsEmpty() bool {
+	return p.PendingRune.IsZero() && p.PendingAsset.IsZero()
+}
+
+// IsComplete return true when both the RUNE and the asset side have arrived
+func (p PendingLiquidity) IsComplete() bool {
+	return !p.PendingRune.IsZero() && !p.PendingAsset.IsZero()
+}
+
+// IsExpired return true when the pending side has waited too long for the other side at the given block height
+func (p PendingLiquidity) IsExpired(height int64) bool {
+	return p.ExpireBlockHeight <= height
+}
+
+// Add add the given amounts sent in by the given tx to the pending liquidity
+func (p *PendingLiquidity) Add(runeAmt, assetAmt sdk.Uint, tx common.Tx) {
+	p.PendingRune = p.PendingRune.Add(runeAmt)
+	p.PendingAsset = p.PendingAsset.Add(assetAmt)
+	p.Txs = append(p.Txs, tx)
+}
+
+// Valid check whether the pending liquidity has all the fields required
+func (p PendingLiquidity) Valid() error {
+	if p.Asset.IsEmpty() {
+		return errors.New("asset cannot be empty")
+	}
+	if p.RuneAddress.IsEmpty() {
+		return errors.New("rune address cannot be empty")
+	}
+	if p.AssetAddress.IsEmpty() {
+		return errors.New("asset address cannot be empty")
+	}
+	if len(p.Txs) == 0 {
+		return errors.New("txs cannot be empty")
+	}
+	return nil
+}
+
+// Key return the key the pending liquidity is stored under, a pending stake is matched by pool and address pair
+func (p PendingLiquidity) Key() string {
+	return fmt.Sprintf("%s/%s/%s", p.Asset.String(), p.RuneAddress.String(), p.AssetAddress.String())
+}
+
+// String implement fmt.Stringer
+func (p PendingLiquidity) String() string {
+	return fmt.Sprintf("%s rune address: %s asset address: %s pending rune: %s pending asset: %s expire block height: %d",
+		p.Asset, p.RuneAddress, p.AssetAddress, p.PendingRune, p.PendingAsset, p.ExpireBlockHeight)
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type PendingLiquiditySuite struct{}
+
+var _ = Suite(&PendingLiquiditySuite{})
+
+func (PendingLiquiditySuite) TestPendingLiquidity(c *C) {
+	runeAddr := GetRandomBNBAddress()
+	btcAddr := GetRandomBTCAddress()
+	p := NewPendingLiquidity(common.BTCAsset, runeAddr, btcAddr)
+	c.Check(p.IsEmpty(), Equals, true)
+	c.Check(p.IsComplete(), Equals, false)
+	c.Check(p.Valid(), NotNil)
+	c.Check(p.Key(), Equals, "BTC.BTC/"+runeAddr.String()+"/"+btcAddr.String())
+
+	p.Add(sdk.NewUint(common.One), sdk.ZeroUint(), GetRandomTx())
+	c.Check(p.Valid(), IsNil)
+	c.Check(p.IsEmpty(), Equals, false)
+	c.Check(p.IsComplete(), Equals, false)
+	p.Add(sdk.ZeroUint(), sdk.NewUint(2*common.One), GetRandomTx())
+	c.Check(p.IsComplete(), Equals, true)
+	c.Check(p.Txs, HasLen, 2)
+	c.Check(p.PendingRune.Equal(sdk.NewUint(common.One)), Equals, true)
+	c.Check(p.PendingAsset.Equal(sdk.NewUint(2*common.One)), Equals, true)
+
+	p.ExpireBlockHeight = 100
+	c.Check(p.IsExpired(99), Equals, false)
+	c.Check(p.IsExpired(100), Equals, true)
+
+	c.Check(NewPendingLiquidity(common.Asset{}, runeAddr, btcAddr).Valid(), NotNil)
+	c.Check(NewPendingLiquidity(common.BTCAsset, common.NoAddress, btcAddr).Valid(), NotNil)
+	c.Check(NewPendingLiquidity(common.BTCAsset, runeAddr, common.NoAddress).Valid(), NotNil)
+}
@@ -37,7 +37,7 @@ func (PoolTestSuite) TestPool(c *C) {
 		BNBGasFeeSingleton,
 		"",
 	)
-	m := NewMsgSwap(tx, common.BNBAsset, bnbAddress, sdk.NewUint(2), signer)
+	m := NewMsgSwap(tx, common.BNBAsset, bnbAddress, sdk.NewUint(2), common.NoAddress, sdk.ZeroUint(), signer)
 
 	c.Check(p.EnsureValidPoolStatus(m), IsNil)
 	msgNoop := NewMsgNoOp(GetRandomObservedTx(), signer)
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SlashReason describe why a node account got slashed
+type SlashReason string
+
+// all the reasons a node account can get slashed for
+const (
+	SlashReasonLackOfObservation SlashReason = "lack_of_observation"
+	SlashReasonFailToSign        SlashReason = "fail_to_sign_outbound"
+	SlashReasonFailKeygen        SlashReason = "fail_keygen"
+	SlashReasonFailKeysign       SlashReason = "fail_keysign"
+	SlashReasonDoubleSign        SlashReason = "double_sign"
+	SlashReasonExcessOutbound    SlashReason = "excess_outbound"
+	SlashReasonYggdrasilLeftover SlashReason = "yggdrasil_leftover"
+)
+
+// IsAggregated returns true when slashes of the reason can happen every block,
+// they are merged into a single slash record instead of one record each
+func (r SlashReason) IsAggregated() bool {
+	return r == SlashReasonLackOfObservation
+}
+
+// SlashRecord is an entry in a node account's slash ledger, either slash points, bond or both.
+// An aggregated record sums Count slashes from Height to EndHeight
+type SlashRecord struct {
+	Height      int64       `json:"height"`
+	EndHeight   int64       `json:"end_height"`
+	Count       int64       `json:"count"`
+	Reason      SlashReason `json:"reason"`
+	SlashPoints int64       `json:"slash_points"`
+	Bond        sdk.Uint    `json:"bond"`
+}
+
+// SlashRecords a list of SlashRecord
+type SlashRecords []SlashRecord
+
+// NewSlashRecord create a new instance of SlashRecord
+func NewSlashRecord(height int64, reason SlashReason, pts int64, bond sdk.Uint) SlashRecord {
+	return SlashRecord{
+		Height:      height,
+		EndHeight:   height,
+		Count:       1,
+		Reason:      reason,
+		SlashPoints: pts,
+		Bond:        bond,
+	}
+}
+
+// IsValid check whether the slash record has all necessary values
+func (r SlashRecord) IsValid() error {
+	if r.Height <= 0 {
+		return errors.New("height cannot be less than or equal to zero")
+	}
+	if r.Reason == "" {
+		return errors.New("reason cannot be empty")
+	}
+	if r.SlashPoints <= 0 && r.Bond.IsZero() {
+		return errors.New("slash record has neither slash points nor bond")
+	}
+	return nil
+}
+
+// Merge adds the slash points and bond of the given record to this one
+func (r *SlashRecord) Merge(other SlashRecord) {
+	r.EndHeight = other.EndHeight
+	r.Count += other.Count
+	r.SlashPoints += other.SlashPoints
+	r.Bond = r.Bond.Add(other.Bond)
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+)
+
+type SlashRecordSuite struct{}
+
+var _ = Suite(&SlashRecordSuite{})
+
+func (SlashRecordSuite) TestSlashRecord(c *C) {
+	r := NewSlashRecord(10, SlashReasonLackOfObservation, 2, sdk.ZeroUint())
+	c.Check(r.IsValid(), IsNil)
+	r = NewSlashRecord(10, SlashReasonExcessOutbound, 0, sdk.NewUint(100))
+	c.Check(r.IsValid(), IsNil)
+	r = NewSlashRecord(0, SlashReasonLackOfObservation, 2, sdk.ZeroUint())
+	c.Check(r.IsValid(), NotNil)
+	r = NewSlashRecord(10, "", 2, sdk.ZeroUint())
+	c.Check(r.IsValid(), NotNil)
+	r = NewSlashRecord(10, SlashReasonDoubleSign, 0, sdk.ZeroUint())
+	c.Check(r.IsValid(), NotNil)
+
+	r = NewSlashRecord(10, SlashReasonLackOfObservation, 2, sdk.ZeroUint())
+	r.Merge(NewSlashRecord(12, SlashReasonLackOfObservation, 2, sdk.NewUint(5)))
+	c.Check(r.Height, Equals, int64(10))
+	c.Check(r.EndHeight, Equals, int64(12))
+	c.Check(r.Count, Equals, int64(2))
+	c.Check(r.SlashPoints, Equals, int64(4))
+	c.Check(r.Bond.Equal(sdk.NewUint(5)), Equals, true)
+	c.Check(SlashReasonLackOfObservation.IsAggregated(), Equals, true)
+	c.Check(SlashReasonFailKeysign.IsAggregated(), Equals, false)
+}
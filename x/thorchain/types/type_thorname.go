@@ -0,0 +1,110 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// MaxTHORNameLength the maximum number of characters of a THORName
+const MaxTHORNameLength = 30
+
+var isValidTHORName = regexp.MustCompile(`^[a-zA-Z0-9+_-]+$`).MatchString
+
+// THORNameAlias the address a THORName resolves to on a chain
+type THORNameAlias struct {
+	Chain   common.Chain   `json:"chain"`
+	Address common.Address `json:"address"`
+}
+
+// THORName a short human readable name registered on THORChain, memos can use it anywhere an address is expected
+type THORName struct {
+	Name              string          `json:"name"`
+	ExpireBlockHeight int64           `json:"expire_block_height"` // THORChain block height the registration expires at
+	Owner             sdk.AccAddress  `json:"owner"`
+	Aliases           []THORNameAlias `json:"aliases"`
+}
+
+// NewTHORName create a new instance of THORName
+func NewTHORName(name string, expireHeight int64, owner sdk.AccAddress) THORName {
+	return THORName{
+		Name:              strings.ToLower(name),
+		ExpireBlockHeight: expireHeight,
+		Owner:             owner,
+	}
+}
+
+// IsValidTHORName return true when the given string can be registered as a THORName,
+// a string that is already a valid address can't be a THORName
+func IsValidTHORName(name string) bool {
+	if len(name) == 0 || len(name) > MaxTHORNameLength || !isValidTHORName(name) {
+		return false
+	}
+	if _, err := common.NewAddress(name); err == nil {
+		return false
+	}
+	return true
+}
+
+// IsEmpty return true when the THORName doesn't exist
+func (n THORName) IsEmpty() bool {
+	return len(n.Name) == 0
+}
+
+// IsExpired return true when the registration of the THORName expired at the given block height
+func (n THORName) IsExpired(height int64) bool {
+	return n.ExpireBlockHeight <= height
+}
+
+// Valid return an error when the THORName is not valid
+func (n THORName) Valid() error {
+	if !IsValidTHORName(n.Name) {
+		return fmt.Errorf("invalid THORName: %s", n.Name)
+	}
+	if n.Owner.Empty() {
+		return errors.New("owner can't be empty")
+	}
+	for _, alias := range n.Aliases {
+		if alias.Chain.IsEmpty() {
+			return errors.New("alias chain can't be empty")
+		}
+		if !alias.Address.IsChain(alias.Chain) {
+			return fmt.Errorf("%s is not a %s address", alias.Address, alias.Chain)
+		}
+	}
+	return nil
+}
+
+// GetAlias return the address the THORName resolves to on the given chain, NoAddress when there is none
+func (n THORName) GetAlias(chain common.Chain) common.Address {
+	for _, alias := range n.Aliases {
+		if alias.Chain.Equals(chain) {
+			return alias.Address
+		}
+	}
+	return common.NoAddress
+}
+
+// SetAlias set the address the THORName resolves to on the given chain, an empty address removes the alias
+func (n *THORName) SetAlias(chain common.Chain, addr common.Address) {
+	aliases := make([]THORNameAlias, 0, len(n.Aliases)+1)
+	for _, alias := range n.Aliases {
+		if !alias.Chain.Equals(chain) {
+			aliases = append(aliases, alias)
+		}
+	}
+	if !addr.IsEmpty() {
+		aliases = append(aliases, THORNameAlias{Chain: chain, Address: addr})
+	}
+	n.Aliases = aliases
+}
+
+// String implement fmt.Stringer
+func (n THORName) String() string {
+	return fmt.Sprintf("name: %s, owner: %s, expire block height: %d", n.Name, n.Owner, n.ExpireBlockHeight)
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type THORNameSuite struct{}
+
+var _ = Suite(&THORNameSuite{})
+
+func (THORNameSuite) TestTHORName(c *C) {
+	owner := GetRandomBech32Addr()
+	n := NewTHORName("Bob", 100, owner)
+	c.Check(n.Name, Equals, "bob")
+	c.Check(n.Valid(), IsNil)
+	c.Check(n.IsEmpty(), Equals, false)
+	c.Check(THORName{}.IsEmpty(), Equals, true)
+	c.Check(n.IsExpired(99), Equals, false)
+	c.Check(n.IsExpired(100), Equals, true)
+
+	bnbAddr := GetRandomBNBAddress()
+	c.Check(n.GetAlias(common.BNBChain).IsEmpty(), Equals, true)
+	n.SetAlias(common.BNBChain, bnbAddr)
+	c.Check(n.GetAlias(common.BNBChain).Equals(bnbAddr), Equals, true)
+	c.Check(n.Valid(), IsNil)
+	bnbAddr = GetRandomBNBAddress()
+	n.SetAlias(common.BNBChain, bnbAddr)
+	c.Check(n.Aliases, HasLen, 1)
+	c.Check(n.GetAlias(common.BNBChain).Equals(bnbAddr), Equals, true)
+	n.SetAlias(common.BNBChain, common.NoAddress)
+	c.Check(n.Aliases, HasLen, 0)
+
+	n.SetAlias(common.BTCChain, bnbAddr)
+	c.Check(n.Valid(), NotNil)
+	c.Check(NewTHORName("bob", 100, nil).Valid(), NotNil)
+	c.Check(NewTHORName("", 100, owner).Valid(), NotNil)
+}
+
+func (THORNameSuite) TestIsValidTHORName(c *C) {
+	for _, name := range []string{"bob", "a", "Bob_2-x+y", "123456789012345678901234567890"} {
+		c.Check(IsValidTHORName(name), Equals, true, Commentf("%s", name))
+	}
+	invalid := []string{
+		"",
+		"1234567890123456789012345678901",
+		"bob:alice",
+		"bob alice",
+		"bnb1xlvns0n2mxh77mzaspn2hgav4rr4m8eerfju38",
+		"0x90f2b1ae50e6018230e90a33f98c7844a0ab635a",
+	}
+	for _, name := range invalid {
+		c.Check(IsValidTHORName(name), Equals, false, Commentf("%s", name))
+	}
+}
@@ -15,6 +15,7 @@ type TxOutItem struct {
 	Coin        common.Coin    `json:"coin"`
 	Memo        string         `json:"memo"`
 	MaxGas      common.Gas     `json:"max_gas"`
+	GasRate     int64          `json:"gas_rate"` // fee rate agreed by the active nodes, the signer multiply it by the size of the outbound tx
 	InHash      common.TxID    `json:"in_hash"`
 	OutHash     common.TxID    `json:"out_hash"`
 }
@@ -29,6 +29,8 @@ const (
 	RetiringVault VaultStatus = "retiring"
 	// InactiveVault means the vault is not active anymore
 	InactiveVault VaultStatus = "inactive"
+	// InitVault means the vault had been created, but it is waiting for the other asgard vaults of the same churn
+	InitVault VaultStatus = "init"
 )
 
 // Vault usually represent the pool we are using
@@ -86,6 +88,19 @@ func (v Vault) Contains(pubkey common.PubKey) bool {
 	return v.Membership.Contains(pubkey)
 }
 
+// MembershipEquals check whether the vault membership is exactly the given pub keys, regardless of order
+func (v Vault) MembershipEquals(pks common.PubKeys) bool {
+	if len(v.Membership) != len(pks) {
+		return false
+	}
+	for _, pk := range pks {
+		if !v.Contains(pk) {
+			return false
+		}
+	}
+	return true
+}
+
 // UpdateStatus set the vault to given status
 func (v *Vault) UpdateStatus(s VaultStatus, height int64) {
 	v.Status = s
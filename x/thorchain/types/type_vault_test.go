@@ -98,3 +98,13 @@ func (s *VaultSuite) TestPendingTxBlockHeights(c *C) {
 	vault.RemovePendingTxBlockHeights(1001)
 	c.Assert(vault.LenPendingTxBlockHeights(1002, constAccessor), Equals, 0)
 }
+
+func (s *VaultSuite) TestMembershipEquals(c *C) {
+	pk1 := GetRandomPubKey()
+	pk2 := GetRandomPubKey()
+	vault := NewVault(12, InitVault, AsgardVault, GetRandomPubKey(), common.Chains{common.BNBChain})
+	vault.Membership = common.PubKeys{pk1, pk2}
+	c.Check(vault.MembershipEquals(common.PubKeys{pk2, pk1}), Equals, true)
+	c.Check(vault.MembershipEquals(common.PubKeys{pk1}), Equals, false)
+	c.Check(vault.MembershipEquals(common.PubKeys{pk1, GetRandomPubKey()}), Equals, false)
+}
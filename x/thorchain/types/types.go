@@ -38,6 +38,19 @@ func HasSuperMajority(signers, total int) bool {
 	return mU.GTE(factor)
 }
 
+// HasThreshold return true when signers reach the 2/3 supermajority as well as
+// the given threshold in basis points, a threshold of zero or less only
+// requires the supermajority
+func HasThreshold(signers, total int, threshold int64) bool {
+	if !HasSuperMajority(signers, total) {
+		return false
+	}
+	if threshold <= 0 {
+		return true
+	}
+	return int64(signers)*10000 >= int64(total)*threshold
+}
+
 // HasMajority return true when it has more than 1/2
 func HasSimpleMajority(signers, total int) bool {
 	if signers > total {
@@ -47,6 +47,15 @@ func (s TypesSuite) TestHasSuperMajority(c *C) {
 	c.Check(HasSuperMajority(3, 0), Equals, false)
 }
 
+func (TypesSuite) TestHasThreshold(c *C) {
+	c.Check(HasThreshold(3, 4, 0), Equals, true)
+	c.Check(HasThreshold(2, 4, 0), Equals, false)
+	c.Check(HasThreshold(9, 10, 9000), Equals, true)
+	c.Check(HasThreshold(8, 10, 9000), Equals, false)
+	// never lower than the supermajority
+	c.Check(HasThreshold(2, 4, 5000), Equals, false)
+}
+
 func (TypesSuite) TestHasSimpleMajority(c *C) {
 	c.Check(HasSimpleMajority(3, 4), Equals, true)
 	c.Check(HasSimpleMajority(2, 3), Equals, true)
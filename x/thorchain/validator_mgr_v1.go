@@ -332,7 +332,7 @@ func (vm *validatorMgrV1) payNodeAccountBondAward(ctx sdk.Context, na NodeAccoun
 	reward := vault.CalcNodeRewards(earnedBlocks)
 
 	// Add to their bond the amount rewarded
-	na.Bond = na.Bond.Add(reward)
+	na.AddBondReward(reward)
 
 	// Minus the number of rune THORNode have awarded them
 	vault.BondRewardRune = common.SafeSub(vault.BondRewardRune, reward)
@@ -545,23 +545,35 @@ func (vm *validatorMgrV1) ragnarokBond(ctx sdk.Context, nth int64) error {
 		}
 		amt := na.Bond.MulUint64(uint64(nth)).QuoUint64(10)
 
-		// refund bond
-		txOutItem := &TxOutItem{
-			Chain:     common.RuneAsset().Chain,
-			ToAddress: na.BondAddress,
-			InHash:    common.BlankTxID,
-			Coin:      common.NewCoin(common.RuneAsset(), amt),
-			Memo:      NewRagnarokMemo(ctx.BlockHeight()).String(),
-		}
-		ok, err := txOutStore.TryAddTxOutItem(ctx, txOutItem)
-		if err != nil {
-			return err
+		// refund bond, split between the node operator and bond providers,
+		// only the refunds that made it into the outbound queue are taken
+		// out of the bond of whoever they are sent to
+		refunded := false
+		for _, refund := range getBondRefunds(na, na.BondAddress, amt) {
+			txOutItem := &TxOutItem{
+				Chain:     common.RuneAsset().Chain,
+				ToAddress: refund.Address,
+				InHash:    common.BlankTxID,
+				Coin:      common.NewCoin(common.RuneAsset(), refund.Amount),
+				Memo:      NewRagnarokMemo(ctx.BlockHeight()).String(),
+			}
+			ok, err := txOutStore.TryAddTxOutItem(ctx, txOutItem)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			if !na.UnbondFrom(refund.Address, refund.Amount) {
+				ctx.Logger().Error("fail to take refund out of bond", "address", refund.Address, "amount", refund.Amount)
+				continue
+			}
+			refunded = true
 		}
-		if !ok {
+		if !refunded {
 			continue
 		}
 
-		na.Bond = common.SafeSub(na.Bond, amt)
 		if err := vm.k.SetNodeAccount(ctx, na); err != nil {
 			return err
 		}
@@ -1003,10 +1015,17 @@ func (vm *validatorMgrV1) nextVaultNodeAccounts(ctx sdk.Context, targetCount int
 		return nil, false, err
 	}
 
-	ready, err := vm.k.ListNodeAccountsByStatus(ctx, NodeReady)
+	readyNodes, err := vm.k.ListNodeAccountsByStatus(ctx, NodeReady)
 	if err != nil {
 		return nil, false, err
 	}
+	// jailed node accounts can't be selected
+	ready := make(NodeAccounts, 0, len(readyNodes))
+	for _, na := range readyNodes {
+		if !na.IsJailed(ctx.BlockHeight()) {
+			ready = append(ready, na)
+		}
+	}
 
 	// sort by bond size
 	sort.SliceStable(ready, func(i, j int) bool {
@@ -1043,6 +1062,19 @@ func (vm *validatorMgrV1) nextVaultNodeAccounts(ctx sdk.Context, targetCount int
 		active = active[toRemove:]
 	}
 
+	// jailed node accounts are churned out of the vault
+	notJailed := make(NodeAccounts, 0, len(active))
+	for _, na := range active {
+		if !na.IsJailed(ctx.BlockHeight()) {
+			notJailed = append(notJailed, na)
+		}
+	}
+	if len(notJailed) < len(active) {
+		rotation = true
+		toRemove += len(active) - len(notJailed)
+		active = notJailed
+	}
+
 	// add ready nodes to become active
 	limit := toRemove + 1 // Max limit of ready nodes to churn in
 	minimumNodesForBFT := constAccessor.GetInt64Value(constants.MinimumNodesForBFT)
@@ -185,3 +185,32 @@ func (vts *ValidatorMgrV1TestSuite) TestFindMaxAbleToLeave(c *C) {
 	c.Check(findMaxAbleToLeave(11), Equals, 3)
 	c.Check(findMaxAbleToLeave(12), Equals, 3)
 }
+
+func (vts *ValidatorMgrV1TestSuite) TestNextVaultNodeAccountsSkipJailed(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(1000)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+
+	versionedTxOutStoreDummy := NewVersionedTxOutStoreDummy()
+	versionedVaultMgrDummy := NewVersionedVaultMgrDummy(versionedTxOutStoreDummy)
+	vMgr := newValidatorMgrV1(k, versionedTxOutStoreDummy, versionedVaultMgrDummy, NewDummyVersionedEventMgr())
+
+	for i := 0; i < 4; i++ {
+		c.Assert(k.SetNodeAccount(ctx, GetRandomNodeAccount(NodeActive)), IsNil)
+	}
+	nas, rotation, err := vMgr.nextVaultNodeAccounts(ctx, 4, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(rotation, Equals, false)
+	c.Check(nas, HasLen, 4)
+
+	jailed := GetRandomNodeAccount(NodeActive)
+	jailed.Jail(ctx.BlockHeight() + 10)
+	c.Assert(k.SetNodeAccount(ctx, jailed), IsNil)
+	nas, rotation, err = vMgr.nextVaultNodeAccounts(ctx, 5, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(rotation, Equals, true)
+	c.Assert(nas, HasLen, 4)
+	for _, na := range nas {
+		c.Check(na.NodeAddress.Equals(jailed.NodeAddress), Equals, false)
+	}
+}
@@ -3,6 +3,7 @@ package thorchain
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/blang/semver"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -103,6 +104,8 @@ func (vm *VaultMgr) EndBlock(ctx sdk.Context, version semver.Version, constAcces
 			if err := vm.k.SetVault(ctx, vault); err != nil {
 				ctx.Logger().Error("fail to set vault to inactive", "error", err)
 			}
+			// the vault is done retiring, nothing will query its plan anymore
+			vm.k.RemoveMigrationPlan(ctx, vault.PubKey)
 			continue
 		}
 
@@ -113,6 +116,14 @@ func (vm *VaultMgr) EndBlock(ctx sdk.Context, version semver.Version, constAcces
 				continue
 			}
 
+			plan, err := vm.k.GetMigrationPlan(ctx, vault.PubKey)
+			if err != nil {
+				return fmt.Errorf("fail to get migration plan: %w", err)
+			}
+			if plan.IsEmpty() {
+				plan = NewMigrationPlan(vault.StatusSince, vault)
+			}
+
 			for _, coin := range vault.Coins {
 
 				// determine which active asgard vault is the best to send
@@ -184,8 +195,12 @@ func (vm *VaultMgr) EndBlock(ctx sdk.Context, version semver.Version, constAcces
 					if err := vm.k.SetVault(ctx, vault); err != nil {
 						return fmt.Errorf("fail to save vault: %w", err)
 					}
+					plan.AddTxIssued(coin)
 				}
 			}
+			if err := vm.k.SetMigrationPlan(ctx, plan); err != nil {
+				return fmt.Errorf("fail to save migration plan: %w", err)
+			}
 		}
 	}
 
@@ -198,27 +213,70 @@ func (vm *VaultMgr) EndBlock(ctx sdk.Context, version semver.Version, constAcces
 	return nil
 }
 
-// TriggerKeygen generate a record to instruct signer kick off keygen process
-func (vm *VaultMgr) TriggerKeygen(ctx sdk.Context, nas NodeAccounts) error {
-	var members common.PubKeys
-	for i := range nas {
-		members = append(members, nas[i].PubKeySet.Secp256k1)
-	}
-	keygen, err := NewKeygen(ctx.BlockHeight(), members, AsgardKeygen)
+// recordMigration update the migration plan of the given retiring vault with a migration tx observed leaving it
+func recordMigration(ctx sdk.Context, keeper Keeper, vault Vault, tx common.Tx) error {
+	plan, err := keeper.GetMigrationPlan(ctx, vault.PubKey)
 	if err != nil {
-		return fmt.Errorf("fail to create a new keygen: %w", err)
+		return err
 	}
+	if plan.IsEmpty() {
+		return nil
+	}
+	plan.AddTxConfirmed(tx.Coins, tx.Gas)
+	return keeper.SetMigrationPlan(ctx, plan)
+}
+
+// TriggerKeygen generate a record to instruct signer kick off keygen process
+// when there are more node accounts than AsgardSize, they are split into
+// multiple asgard vaults, and each of them get its own keygen
+func (vm *VaultMgr) TriggerKeygen(ctx sdk.Context, nas NodeAccounts) error {
 	keygenBlock, err := vm.k.GetKeygenBlock(ctx, ctx.BlockHeight())
 	if err != nil {
 		return fmt.Errorf("fail to get keygen block from data store: %w", err)
 	}
-
-	if !keygenBlock.Contains(keygen) {
-		keygenBlock.Keygens = append(keygenBlock.Keygens, keygen)
+	for _, shard := range vm.shardNodeAccounts(ctx, nas) {
+		var members common.PubKeys
+		for i := range shard {
+			members = append(members, shard[i].PubKeySet.Secp256k1)
+		}
+		keygen, err := NewKeygen(ctx.BlockHeight(), members, AsgardKeygen)
+		if err != nil {
+			return fmt.Errorf("fail to create a new keygen: %w", err)
+		}
+		if !keygenBlock.Contains(keygen) {
+			keygenBlock.Keygens = append(keygenBlock.Keygens, keygen)
+		}
 	}
 	return vm.k.SetKeygenBlock(ctx, keygenBlock)
 }
 
+// shardNodeAccounts split the given node accounts into groups of at most
+// AsgardSize node accounts. Node accounts are dealt out by bond, so each
+// group end up with a similar amount of bond
+func (vm *VaultMgr) shardNodeAccounts(ctx sdk.Context, nas NodeAccounts) []NodeAccounts {
+	constAccessor := constants.GetConstantValues(vm.k.GetLowestActiveVersion(ctx))
+	asgardSize, err := vm.k.GetMimir(ctx, constants.AsgardSize.String())
+	if (asgardSize < 0 || err != nil) && constAccessor != nil {
+		asgardSize = constAccessor.GetInt64Value(constants.AsgardSize)
+	}
+	if asgardSize <= 0 || int64(len(nas)) <= asgardSize {
+		return []NodeAccounts{nas}
+	}
+
+	sorted := make(NodeAccounts, len(nas))
+	copy(sorted, nas)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Bond.GT(sorted[j].Bond)
+	})
+	count := (int64(len(sorted)) + asgardSize - 1) / asgardSize
+	shards := make([]NodeAccounts, count)
+	for i, na := range sorted {
+		shards[int64(i)%count] = append(shards[int64(i)%count], na)
+	}
+	return shards
+}
+
+// RotateVault make the given vault active, and retire the active asgard vaults that share members with it
 func (vm *VaultMgr) RotateVault(ctx sdk.Context, vault Vault) error {
 	active, err := vm.k.GetAsgardVaultsByStatus(ctx, ActiveVault)
 	if err != nil {
@@ -254,6 +312,9 @@ func (vm *VaultMgr) RotateVault(ctx sdk.Context, vault Vault) error {
 		}
 	}
 
+	if vault.Status != ActiveVault {
+		vault.UpdateStatus(ActiveVault, ctx.BlockHeight())
+	}
 	if err := vm.k.SetVault(ctx, vault); err != nil {
 		return err
 	}
@@ -221,3 +221,71 @@ func (s *ValidatorManagerTestSuite) TestRagnarokChain(c *C) {
 	c.Check(items[0].Memo, Equals, NewYggdrasilReturn(ctx.BlockHeight()).String())
 	c.Check(items[0].Chain.Equals(common.BTCChain), Equals, true)
 }
+
+func (s *VaultManagerTestSuite) TestShardNodeAccounts(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	vaultMgr := NewVaultMgr(k, NewVersionedTxOutStoreDummy(), NewDummyVersionedEventMgr())
+
+	nas := make(NodeAccounts, 10)
+	for i := range nas {
+		nas[i] = GetRandomNodeAccount(NodeActive)
+		nas[i].Bond = sdk.NewUint(uint64(i+1) * common.One)
+	}
+
+	// nodes fit in a single asgard vault
+	shards := vaultMgr.shardNodeAccounts(ctx, nas)
+	c.Assert(shards, HasLen, 1)
+	c.Check(shards[0], HasLen, 10)
+
+	k.SetMimir(ctx, constants.AsgardSize.String(), 4)
+	shards = vaultMgr.shardNodeAccounts(ctx, nas)
+	c.Assert(shards, HasLen, 3)
+	c.Check(shards[0], HasLen, 4)
+	c.Check(shards[1], HasLen, 3)
+	c.Check(shards[2], HasLen, 3)
+	// node with the highest bond goes to the first shard, second highest to the second one
+	c.Check(shards[0][0].NodeAddress.Equals(nas[9].NodeAddress), Equals, true)
+	c.Check(shards[1][0].NodeAddress.Equals(nas[8].NodeAddress), Equals, true)
+}
+
+func (s *VaultManagerTestSuite) TestMigrationPlan(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	migrateInterval := constAccessor.GetInt64Value(constants.FundMigrationInterval)
+
+	active := GetRandomVault()
+	c.Assert(k.SetVault(ctx, active), IsNil)
+	retiring := GetRandomVault()
+	retiring.Status = RetiringVault
+	retiring.StatusSince = 10
+	retiring.AddFunds(common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(100*common.One)),
+	})
+	c.Assert(k.SetVault(ctx, retiring), IsNil)
+
+	vaultMgr := NewVaultMgr(k, NewVersionedTxOutStoreDummy(), NewDummyVersionedEventMgr())
+
+	// the plan starts at the height the vault started retiring, not at the
+	// first migration round
+	ctx = ctx.WithBlockHeight(retiring.StatusSince + migrateInterval)
+	c.Assert(vaultMgr.EndBlock(ctx, ver, constAccessor), IsNil)
+	plan, err := k.GetMigrationPlan(ctx, retiring.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(plan.IsEmpty(), Equals, false)
+	c.Check(plan.StartHeight, Equals, retiring.StatusSince)
+
+	// the plan is deleted once the vault is inactive
+	retiring, err = k.GetVault(ctx, retiring.PubKey)
+	c.Assert(err, IsNil)
+	retiring.Coins = nil
+	c.Assert(k.SetVault(ctx, retiring), IsNil)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	c.Assert(vaultMgr.EndBlock(ctx, ver, constAccessor), IsNil)
+	retiring, err = k.GetVault(ctx, retiring.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(retiring.Status, Equals, InactiveVault)
+	plan, err = k.GetMigrationPlan(ctx, retiring.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(plan.IsEmpty(), Equals, true)
+}
@@ -10,6 +10,9 @@ import (
 	"gitlab.com/thorchain/thornode/constants"
 )
 
+// HaltYggdrasilMimirKey the mimir key, formatted with a chain, that halts yggdrasil on that chain when set above 0
+const HaltYggdrasilMimirKey = "HaltYggdrasil-%s"
+
 // Fund is a method to fund yggdrasil pool
 func Fund(ctx sdk.Context, keeper Keeper, txOutStore TxOutStore, constAccessor constants.ConstantValues) error {
 	// Check if we have triggered the ragnarok protocol
@@ -59,6 +62,11 @@ func Fund(ctx sdk.Context, keeper Keeper, txOutStore TxOutStore, constAccessor c
 	// With 100 Ygg pools, THORNode should check each pool every 8.33 minutes.
 	na := nodeAccs[ctx.BlockHeight()%int64(len(nodeAccs))]
 
+	// don't fund node accounts that are being churned out or are in jail
+	if na.ForcedToLeave || na.IsJailed(ctx.BlockHeight()) {
+		return nil
+	}
+
 	// check that we have enough bond
 	minBond, err := keeper.GetMimir(ctx, constants.MinimumBondInRune.String())
 	if minBond < 0 || err != nil {
@@ -91,18 +99,31 @@ func Fund(ctx sdk.Context, keeper Keeper, txOutStore TxOutStore, constAccessor c
 		return fmt.Errorf("cannot send more yggdrasil funds while transactions are pending (%s: %d)", ygg.PubKey, pendingTxCount)
 	}
 
-	// calculate the total value of funds of this yggdrasil vault
-	totalValue := sdk.ZeroUint()
-	for _, coin := range ygg.Coins {
-		if coin.Asset.IsRune() {
-			totalValue = totalValue.Add(coin.Amount)
-			continue
+	// recall funds first when the yggdrasil vault holds more than it should,
+	// which happens when yggdrasil get halted on a chain, or when pool prices
+	// moved since the vault had been funded
+	recallCoins, err := calcYggRecallCoins(ctx, keeper, ygg, na.Bond, constAccessor)
+	if err != nil {
+		return err
+	}
+	if len(recallCoins) > 0 {
+		count, err := recallCoinsFromYggdrasil(ctx, keeper, recallCoins, ygg, txOutStore)
+		if err != nil {
+			return err
 		}
-		for _, pool := range pools {
-			if pool.Asset.Equals(coin.Asset) {
-				totalValue = totalValue.Add(pool.AssetValueInRune(coin.Amount))
-			}
+		for i := 0; i < count; i++ {
+			ygg.AppendPendingTxBlockHeights(ctx.BlockHeight(), constAccessor)
 		}
+		if err := keeper.SetVault(ctx, ygg); err != nil {
+			return fmt.Errorf("fail to save yggdrasil pool: %w", err)
+		}
+		return nil
+	}
+
+	// calculate the total value of funds of this yggdrasil vault
+	totalValue, err := getTotalYggValueInRune(ctx, keeper, ygg)
+	if err != nil {
+		return fmt.Errorf("fail to get total ygg value in RUNE: %w", err)
 	}
 
 	// if the ygg total value is more than 25% bond, funds are low enough yet
@@ -115,6 +136,10 @@ func Fund(ctx sdk.Context, keeper Keeper, txOutStore TxOutStore, constAccessor c
 	if err != nil {
 		return err
 	}
+	targetCoins, err = applyYggFundLimits(ctx, keeper, ygg, targetCoins, na.Bond, constAccessor)
+	if err != nil {
+		return err
+	}
 
 	var sendCoins common.Coins
 	// iterate over each target coin amount and figure if THORNode need to reimburse
@@ -197,6 +222,218 @@ func sendCoinsToYggdrasil(ctx sdk.Context, keeper Keeper, coins common.Coins, yg
 	return count, nil
 }
 
+// recallCoinsFromYggdrasil - adds outbound txs to return the given coins from
+// a yggdrasil pool back to asgard
+func recallCoinsFromYggdrasil(ctx sdk.Context, keeper Keeper, coins common.Coins, ygg Vault, txOutStore TxOutStore) (int, error) {
+	var count int
+
+	active, err := keeper.GetAsgardVaultsByStatus(ctx, ActiveVault)
+	if err != nil {
+		return count, err
+	}
+
+	for _, coin := range coins {
+		// select active vault to return funds to
+		vault := active.SelectByMinCoin(coin.Asset)
+		if vault.IsEmpty() {
+			continue
+		}
+
+		to, err := vault.PubKey.GetAddress(coin.Asset.Chain)
+		if err != nil {
+			ctx.Logger().Error("fail to get address for pubkey", "pubkey", vault.PubKey, "chain", coin.Asset.Chain, "error", err)
+			continue
+		}
+
+		toi := &TxOutItem{
+			Chain:       coin.Asset.Chain,
+			ToAddress:   to,
+			InHash:      common.BlankTxID,
+			Memo:        NewYggdrasilReturn(ctx.BlockHeight()).String(),
+			Coin:        coin,
+			VaultPubKey: ygg.PubKey,
+		}
+		if err := txOutStore.UnSafeAddTxOutItem(ctx, toi); err != nil {
+			return count, err
+		}
+		count += 1
+	}
+
+	return count, nil
+}
+
+// isYggdrasilHalted - check whether yggdrasil had been disabled on the given
+// chain through mimir
+func isYggdrasilHalted(ctx sdk.Context, keeper Keeper, chain common.Chain) bool {
+	halt, err := keeper.GetMimir(ctx, fmt.Sprintf(HaltYggdrasilMimirKey, chain))
+	return err == nil && halt > 0
+}
+
+// getYggRecallBuffer - get the share (basis points) of a limit the value of a
+// yggdrasil pool can go above before the excess is recalled
+func getYggRecallBuffer(ctx sdk.Context, keeper Keeper, constAccessor constants.ConstantValues) sdk.Uint {
+	buffer, err := keeper.GetMimir(ctx, constants.YggRecallBuffer.String())
+	if buffer < 0 || err != nil {
+		buffer = constAccessor.GetInt64Value(constants.YggRecallBuffer)
+	}
+	return sdk.NewUint(uint64(buffer))
+}
+
+// aboveYggRecallBuffer - check whether the given value is far enough above the
+// given limit for the excess to be recalled
+func aboveYggRecallBuffer(value, limit, buffer sdk.Uint) bool {
+	return value.GT(limit.Add(common.GetShare(buffer, sdk.NewUint(10000), limit)))
+}
+
+// getYggFundLimit - get the maximum value (in rune) of the assets on the given
+// chain a yggdrasil pool can hold, as a share of its bond
+func getYggFundLimit(ctx sdk.Context, keeper Keeper, chain common.Chain, yggBond sdk.Uint, constAccessor constants.ConstantValues) sdk.Uint {
+	key := fmt.Sprintf("%s-%s", constants.YggFundLimit, chain)
+	limit, err := keeper.GetMimir(ctx, key)
+	if limit < 0 || err != nil {
+		limit, err = keeper.GetMimir(ctx, constants.YggFundLimit.String())
+		if limit < 0 || err != nil {
+			limit = constAccessor.GetInt64Value(constants.YggFundLimit)
+		}
+	}
+	return common.GetShare(sdk.NewUint(uint64(limit)), sdk.NewUint(10000), yggBond)
+}
+
+// getCoinValueInRune - get the value of the given coin in rune
+func getCoinValueInRune(ctx sdk.Context, keeper Keeper, coin common.Coin) (sdk.Uint, error) {
+	if coin.Asset.IsRune() {
+		return coin.Amount, nil
+	}
+	pool, err := keeper.GetPool(ctx, coin.Asset)
+	if err != nil {
+		return sdk.ZeroUint(), err
+	}
+	return pool.AssetValueInRune(coin.Amount), nil
+}
+
+// calcYggRecallCoins - calculate the coins a yggdrasil pool should return to
+// asgard. That is all of its funds on chains yggdrasil is halted on, the value
+// above the fund limit of each chain, and the value above what the minimum
+// bond to yggdrasil value ratio allows. A limit is only enforced once the
+// value goes above it by more than the recall buffer, it is then brought back
+// down to the limit, so small price moves don't recall dust every block.
+func calcYggRecallCoins(ctx sdk.Context, keeper Keeper, ygg Vault, yggBond sdk.Uint, constAccessor constants.ConstantValues) (common.Coins, error) {
+	buffer := getYggRecallBuffer(ctx, keeper, constAccessor)
+	values := make([]sdk.Uint, len(ygg.Coins))
+	recall := make([]sdk.Uint, len(ygg.Coins))
+	chains := make(common.Chains, 0)
+	for i, coin := range ygg.Coins {
+		value, err := getCoinValueInRune(ctx, keeper, coin)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get value of %s in RUNE: %w", coin, err)
+		}
+		values[i] = value
+		recall[i] = sdk.ZeroUint()
+		chains = append(chains, coin.Asset.Chain)
+	}
+
+	for _, chain := range chains.Distinct() {
+		halted := isYggdrasilHalted(ctx, keeper, chain)
+		chainValue := sdk.ZeroUint()
+		for i, coin := range ygg.Coins {
+			if coin.Asset.Chain.Equals(chain) {
+				chainValue = chainValue.Add(values[i])
+			}
+		}
+		limit := getYggFundLimit(ctx, keeper, chain, yggBond, constAccessor)
+		for i, coin := range ygg.Coins {
+			if !coin.Asset.Chain.Equals(chain) {
+				continue
+			}
+			if halted {
+				recall[i] = coin.Amount
+				continue
+			}
+			if aboveYggRecallBuffer(chainValue, limit, buffer) {
+				recall[i] = common.GetShare(common.SafeSub(chainValue, limit), chainValue, coin.Amount)
+			}
+		}
+	}
+
+	ratio, err := keeper.GetMimir(ctx, constants.MinimumBondToYggRatio.String())
+	if ratio < 0 || err != nil {
+		ratio = constAccessor.GetInt64Value(constants.MinimumBondToYggRatio)
+	}
+	if ratio > 0 {
+		// the value left in the yggdrasil pool once the recalls above are done
+		remaining := sdk.ZeroUint()
+		for i, coin := range ygg.Coins {
+			remaining = remaining.Add(common.GetShare(common.SafeSub(coin.Amount, recall[i]), coin.Amount, values[i]))
+		}
+		maxValue := common.GetShare(sdk.NewUint(10000), sdk.NewUint(uint64(ratio)), yggBond)
+		if aboveYggRecallBuffer(remaining, maxValue, buffer) {
+			excess := common.SafeSub(remaining, maxValue)
+			for i, coin := range ygg.Coins {
+				left := common.SafeSub(coin.Amount, recall[i])
+				recall[i] = recall[i].Add(common.GetShare(excess, remaining, left))
+			}
+		}
+	}
+
+	var coins common.Coins
+	for i, coin := range ygg.Coins {
+		if !recall[i].IsZero() {
+			coins = append(coins, common.NewCoin(coin.Asset, recall[i]))
+		}
+	}
+	return coins, nil
+}
+
+// applyYggFundLimits - remove the coins on chains yggdrasil is halted on from
+// the coins to send to a yggdrasil pool, and reduce the coins that would take
+// the yggdrasil pool above the fund limit of their chain
+func applyYggFundLimits(ctx sdk.Context, keeper Keeper, ygg Vault, coins common.Coins, yggBond sdk.Uint, constAccessor constants.ConstantValues) (common.Coins, error) {
+	chains := make(common.Chains, 0)
+	for _, coin := range coins {
+		chains = append(chains, coin.Asset.Chain)
+	}
+
+	var result common.Coins
+	for _, chain := range chains.Distinct() {
+		if isYggdrasilHalted(ctx, keeper, chain) {
+			continue
+		}
+		chainValue := sdk.ZeroUint()
+		for _, coin := range ygg.Coins {
+			if !coin.Asset.Chain.Equals(chain) {
+				continue
+			}
+			value, err := getCoinValueInRune(ctx, keeper, coin)
+			if err != nil {
+				return nil, fmt.Errorf("fail to get value of %s in RUNE: %w", coin, err)
+			}
+			chainValue = chainValue.Add(value)
+		}
+		room := common.SafeSub(getYggFundLimit(ctx, keeper, chain, yggBond, constAccessor), chainValue)
+		for _, coin := range coins {
+			if !coin.Asset.Chain.Equals(chain) {
+				continue
+			}
+			if room.IsZero() {
+				break
+			}
+			value, err := getCoinValueInRune(ctx, keeper, coin)
+			if err != nil {
+				return nil, fmt.Errorf("fail to get value of %s in RUNE: %w", coin, err)
+			}
+			if value.GT(room) {
+				coin.Amount = common.GetShare(room, value, coin.Amount)
+				value = room
+			}
+			room = common.SafeSub(room, value)
+			if !coin.IsEmpty() {
+				result = append(result, coin)
+			}
+		}
+	}
+	return result, nil
+}
+
 // calcTargetYggCoins - calculate the amount of coins of each pool a yggdrasil
 // pool should have, relative to how much they have bonded (which should be
 // target == bond / 2).
@@ -1,6 +1,8 @@
 package thorchain
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "gopkg.in/check.v1"
 
@@ -136,3 +138,195 @@ func (s YggdrasilSuite) TestFund(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(items, HasLen, 2)
 }
+
+func (s YggdrasilSuite) TestFundSkipsLeavingAndJailedNodes(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.RuneAsset(), sdk.NewUint(10000*common.One)),
+		common.NewCoin(common.BNBAsset, sdk.NewUint(10000*common.One)),
+	}
+	k.SetVault(ctx, vault)
+	bnbPool := NewPool()
+	bnbPool.Asset = common.BNBAsset
+	bnbPool.BalanceAsset = sdk.NewUint(100000 * common.One)
+	bnbPool.BalanceRune = sdk.NewUint(100000 * common.One)
+	c.Assert(k.SetPool(ctx, bnbPool), IsNil)
+
+	// setup 7 active nodes, either forced to leave or in jail
+	for i := 0; i < 7; i++ {
+		na := GetRandomNodeAccount(NodeActive)
+		na.Bond = sdk.NewUint(common.One * 1000000)
+		if i%2 == 0 {
+			na.ForcedToLeave = true
+		} else {
+			na.Jail(ctx.BlockHeight() + 100)
+		}
+		c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	}
+	txOutStore := NewTxStoreDummy()
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	for i := int64(0); i < 7; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		txOutStore.NewBlock(ctx.BlockHeight(), constAccessor)
+		c.Assert(Fund(ctx, k, txOutStore, constAccessor), IsNil)
+		items, err := txOutStore.GetOutboundItems(ctx)
+		c.Assert(err, IsNil)
+		c.Assert(items, HasLen, 0)
+	}
+}
+
+func (s YggdrasilSuite) TestCalcYggRecallCoins(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+
+	bnbPool := NewPool()
+	bnbPool.Asset = common.BNBAsset
+	bnbPool.BalanceRune = sdk.NewUint(1000 * common.One)
+	bnbPool.BalanceAsset = sdk.NewUint(500 * common.One)
+	c.Assert(k.SetPool(ctx, bnbPool), IsNil)
+	btcPool := NewPool()
+	btcPool.Asset = common.BTCAsset
+	btcPool.BalanceRune = sdk.NewUint(3000 * common.One)
+	btcPool.BalanceAsset = sdk.NewUint(225 * common.One)
+	c.Assert(k.SetPool(ctx, btcPool), IsNil)
+
+	ygg := GetRandomVault()
+	ygg.Type = YggdrasilVault
+	ygg.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(100*common.One)),
+		common.NewCoin(common.BTCAsset, sdk.NewUint(15*common.One)),
+		common.NewCoin(common.RuneAsset(), sdk.NewUint(100*common.One)),
+	}
+	bond := sdk.NewUint(1000 * common.One)
+
+	// within limits, nothing to recall
+	coins, err := calcYggRecallCoins(ctx, k, ygg, bond, constAccessor)
+	c.Assert(err, IsNil)
+	c.Assert(coins, HasLen, 0)
+
+	// halting yggdrasil on a chain recall all its funds on that chain
+	k.SetMimir(ctx, fmt.Sprintf(HaltYggdrasilMimirKey, common.BTCChain), 1)
+	coins, err = calcYggRecallCoins(ctx, k, ygg, bond, constAccessor)
+	c.Assert(err, IsNil)
+	c.Assert(coins, HasLen, 1)
+	c.Check(coins[0].Equals(common.NewCoin(common.BTCAsset, sdk.NewUint(15*common.One))), Equals, true, Commentf("%s", coins[0]))
+	k.SetMimir(ctx, fmt.Sprintf(HaltYggdrasilMimirKey, common.BTCChain), 0)
+
+	// BTC value is above its fund limit, but within the recall buffer
+	k.SetMimir(ctx, constants.MinimumBondToYggRatio.String(), 0)
+	btcPool.BalanceRune = sdk.NewUint(7800 * common.One)
+	c.Assert(k.SetPool(ctx, btcPool), IsNil)
+	coins, err = calcYggRecallCoins(ctx, k, ygg, bond, constAccessor)
+	c.Assert(err, IsNil)
+	c.Assert(coins, HasLen, 0)
+
+	// BTC value is beyond the recall buffer, it is brought back down to the fund limit
+	btcPool.BalanceRune = sdk.NewUint(8400 * common.One)
+	c.Assert(k.SetPool(ctx, btcPool), IsNil)
+	coins, err = calcYggRecallCoins(ctx, k, ygg, bond, constAccessor)
+	c.Assert(err, IsNil)
+	c.Assert(coins, HasLen, 1)
+	c.Check(coins[0].Equals(common.NewCoin(common.BTCAsset, sdk.NewUint(160714286))), Equals, true, Commentf("%s", coins[0]))
+
+	// BTC price spike, the value above the BTC fund limit is recalled, and
+	// then the value above the bond to yggdrasil ratio
+	btcPool.BalanceRune = sdk.NewUint(30000 * common.One)
+	c.Assert(k.SetPool(ctx, btcPool), IsNil)
+	k.SetMimir(ctx, constants.MinimumBondToYggRatio.String(), 20000)
+	coins, err = calcYggRecallCoins(ctx, k, ygg, bond, constAccessor)
+	c.Assert(err, IsNil)
+	c.Assert(coins, HasLen, 3)
+	c.Check(coins[0].Equals(common.NewCoin(common.BNBAsset, sdk.NewUint(37.5*common.One))), Equals, true, Commentf("%s", coins[0]))
+	c.Check(coins[1].Equals(common.NewCoin(common.BTCAsset, sdk.NewUint(12.65625*common.One))), Equals, true, Commentf("%s", coins[1]))
+	c.Check(coins[2].Equals(common.NewCoin(common.RuneAsset(), sdk.NewUint(37.5*common.One))), Equals, true, Commentf("%s", coins[2]))
+}
+
+func (s YggdrasilSuite) TestApplyYggFundLimits(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+
+	bnbPool := NewPool()
+	bnbPool.Asset = common.BNBAsset
+	bnbPool.BalanceRune = sdk.NewUint(1000 * common.One)
+	bnbPool.BalanceAsset = sdk.NewUint(500 * common.One)
+	c.Assert(k.SetPool(ctx, bnbPool), IsNil)
+	btcPool := NewPool()
+	btcPool.Asset = common.BTCAsset
+	btcPool.BalanceRune = sdk.NewUint(3000 * common.One)
+	btcPool.BalanceAsset = sdk.NewUint(225 * common.One)
+	c.Assert(k.SetPool(ctx, btcPool), IsNil)
+
+	ygg := GetRandomVault()
+	ygg.Type = YggdrasilVault
+	ygg.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(25*common.One)),
+	}
+	bond := sdk.NewUint(1000 * common.One)
+	coins := common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(100*common.One)),
+		common.NewCoin(common.BTCAsset, sdk.NewUint(15*common.One)),
+		common.NewCoin(common.RuneAsset(), sdk.NewUint(100*common.One)),
+	}
+
+	result, err := applyYggFundLimits(ctx, k, ygg, coins, bond, constAccessor)
+	c.Assert(err, IsNil)
+	c.Assert(result, HasLen, 3)
+
+	// BNB chain is limited to 20% of bond, the vault already holds 50 RUNE worth of BNB
+	k.SetMimir(ctx, "YggFundLimit-BNB", 2000)
+	k.SetMimir(ctx, fmt.Sprintf(HaltYggdrasilMimirKey, common.BTCChain), 1)
+	result, err = applyYggFundLimits(ctx, k, ygg, coins, bond, constAccessor)
+	c.Assert(err, IsNil)
+	c.Assert(result, HasLen, 1)
+	c.Check(result[0].Equals(common.NewCoin(common.BNBAsset, sdk.NewUint(75*common.One))), Equals, true, Commentf("%s", result[0]))
+}
+
+func (s YggdrasilSuite) TestFundRecall(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.RuneAsset(), sdk.NewUint(10000*common.One)),
+		common.NewCoin(common.BNBAsset, sdk.NewUint(10000*common.One)),
+	}
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+
+	for i := 0; i < 7; i++ {
+		na := GetRandomNodeAccount(NodeActive)
+		na.Bond = sdk.NewUint(common.One * 1000000)
+		c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	}
+	bnbPool := NewPool()
+	bnbPool.Asset = common.BNBAsset
+	bnbPool.BalanceAsset = sdk.NewUint(100000 * common.One)
+	bnbPool.BalanceRune = sdk.NewUint(100000 * common.One)
+	c.Assert(k.SetPool(ctx, bnbPool), IsNil)
+
+	nodeAccs, err := k.ListActiveNodeAccounts(ctx)
+	c.Assert(err, IsNil)
+	na := nodeAccs[ctx.BlockHeight()%int64(len(nodeAccs))]
+	ygg := NewVault(ctx.BlockHeight(), ActiveVault, YggdrasilVault, na.PubKeySet.Secp256k1, nil)
+	ygg.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, sdk.NewUint(1000*common.One)),
+	}
+	c.Assert(k.SetVault(ctx, ygg), IsNil)
+
+	// yggdrasil halted on BNB chain, funds get recalled instead of topped up
+	k.SetMimir(ctx, fmt.Sprintf(HaltYggdrasilMimirKey, common.BNBChain), 1)
+	txOutStore := NewTxStoreDummy()
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	txOutStore.NewBlock(ctx.BlockHeight(), constAccessor)
+	c.Assert(Fund(ctx, k, txOutStore, constAccessor), IsNil)
+	items, err := txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].VaultPubKey.Equals(ygg.PubKey), Equals, true)
+	c.Check(items[0].Coin.Equals(common.NewCoin(common.BNBAsset, sdk.NewUint(1000*common.One))), Equals, true)
+	c.Check(items[0].Memo, Equals, NewYggdrasilReturn(ctx.BlockHeight()).String())
+
+	ygg, err = k.GetVault(ctx, ygg.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(ygg.LenPendingTxBlockHeights(ctx.BlockHeight(), constAccessor), Equals, 1)
+}